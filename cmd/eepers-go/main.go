@@ -7,6 +7,7 @@ import (
 	"runtime"
 
 	"github.com/engpetarmarinov/eepers-go/pkg/audio"
+	"github.com/engpetarmarinov/eepers-go/pkg/console"
 	"github.com/engpetarmarinov/eepers-go/pkg/entities"
 	"github.com/engpetarmarinov/eepers-go/pkg/game"
 	"github.com/engpetarmarinov/eepers-go/pkg/input"
@@ -39,8 +40,13 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
+	err = game.LoadRules("assets/rules.json")
+	if err != nil {
+		panic(err)
+	}
 
 	gs := &game.State{}
+	gs.Settings = game.DefaultSettings()
 
 	// Configure worlds and their levels
 	gs.WorldConfig = game.WorldConfig{
@@ -71,6 +77,10 @@ func main() {
 	gs.Camera.Zoom = 1.0
 	gs.Menu = game.NewMenuState()
 
+	// Developer console with the gameplay commands registered
+	devConsole := console.New()
+	gs.RegisterCommands(devConsole)
+
 	// Start playing ambient music
 	rl.PlayMusicStream(audio.AmbientMusic)
 
@@ -84,6 +94,16 @@ func main() {
 		gs.Camera.Offset = rl.NewVector2(float32(screenWidth/2), float32(screenHeight/2))
 		inputState := input.GetInput()
 
+		// The developer console captures the keyboard while it is open
+		consoleWasOpen := devConsole.IsOpen
+		if inputState.ConsoleToggle {
+			devConsole.Toggle()
+		}
+		devConsole.Update()
+		if consoleWasOpen || devConsole.IsOpen {
+			inputState = input.InputState{}
+		}
+
 		// Update music stream
 		rl.UpdateMusicStream(audio.AmbientMusic)
 
@@ -99,6 +119,28 @@ func main() {
 			}
 		}
 
+		// Debug overlay controls work regardless of menu state
+		if inputState.DebugToggle {
+			gs.ToggleDebugOverlay()
+		}
+		if inputState.DebugNextEeper && gs.Debug.Enabled {
+			gs.SelectNextDebugEeper()
+		}
+		if inputState.SnapshotSave {
+			if err := gs.SaveSnapshot(game.DefaultSnapshotPath, true); err != nil {
+				rl.TraceLog(rl.LogWarning, "SNAPSHOT: could not save: %v", err)
+			} else {
+				rl.TraceLog(rl.LogInfo, "SNAPSHOT: saved to %s", game.DefaultSnapshotPath)
+			}
+		}
+		if inputState.SnapshotLoad {
+			if err := gs.LoadSnapshot(game.DefaultSnapshotPath); err != nil {
+				rl.TraceLog(rl.LogWarning, "SNAPSHOT: could not load: %v", err)
+			} else {
+				rl.TraceLog(rl.LogInfo, "SNAPSHOT: loaded from %s", game.DefaultSnapshotPath)
+			}
+		}
+
 		// Handle menu input when menu is open
 		if gs.Menu.IsOpen {
 			if inputState.MenuNavigateUp {
@@ -135,6 +177,16 @@ func main() {
 					}
 					gs.Menu.CloseMenu()
 					rl.ResumeMusicStream(audio.AmbientMusic)
+				case game.MenuDifficulty:
+					// Cycle through presets without closing the menu
+					err = gs.CycleDifficulty()
+					if err != nil {
+						panic(err)
+					}
+				case game.MenuVisionCones:
+					gs.Settings.ShowVisionCones = !gs.Settings.ShowVisionCones
+				case game.MenuRealTime:
+					gs.Settings.RealTime = !gs.Settings.RealTime
 				case game.MenuQuit:
 					// Set quit flag to exit gracefully
 					gs.ShouldQuit = true
@@ -161,47 +213,62 @@ func main() {
 				if shouldMove {
 					// Track movement speed for sprint tutorial
 					gs.TutorialTrackMovementSpeed(inputState.IsRunning)
+					gs.Player.Sprinting = inputState.IsRunning
 
 					// Right
 					if inputState.MoveRight {
 						gs.PlayerTurn(game.Right)
 						gs.TurnAnimation = 1.0
-						gs.ItemsTurn()
-						gs.UpdateEepers()
-						gs.UpdateBombs()
+						if !gs.RealTimeEnabled() {
+							gs.WorldTurn()
+						}
 					}
 					// Left
 					if inputState.MoveLeft {
 						gs.PlayerTurn(game.Left)
 						gs.TurnAnimation = 1.0
-						gs.ItemsTurn()
-						gs.UpdateEepers()
-						gs.UpdateBombs()
+						if !gs.RealTimeEnabled() {
+							gs.WorldTurn()
+						}
 					}
 					// Up
 					if inputState.MoveUp {
 						gs.PlayerTurn(game.Up)
 						gs.TurnAnimation = 1.0
-						gs.ItemsTurn()
-						gs.UpdateEepers()
-						gs.UpdateBombs()
+						if !gs.RealTimeEnabled() {
+							gs.WorldTurn()
+						}
 					}
 					// Down
 					if inputState.MoveDown {
 						gs.PlayerTurn(game.Down)
 						gs.TurnAnimation = 1.0
-						gs.ItemsTurn()
-						gs.UpdateEepers()
-						gs.UpdateBombs()
+						if !gs.RealTimeEnabled() {
+							gs.WorldTurn()
+						}
 					}
 				}
 
 				if inputState.PlaceBomb {
 					gs.PlantBomb()
 				}
+				if inputState.CycleBomb {
+					gs.CycleBombKind()
+				}
+				if inputState.DetonateBombs {
+					gs.DetonateRemoteBombs()
+				}
+			}
+
+			// In real-time mode the world keeps moving without the player, unless
+			// the developer console is open
+			if !devConsole.IsOpen {
+				gs.UpdateRealTime(rl.GetFrameTime())
 			}
 
 			gs.UpdateExplosions()
+			gs.UpdateNoises()
+			gs.UpdateWiring()
 			gs.UpdatePortals()
 			ui.UpdatePlayerEyes(&gs.Player)
 
@@ -294,6 +361,7 @@ func main() {
 			for x, cell := range row {
 				color := world.CellColor(cell)
 				rl.DrawRectangle(int32(x*50), int32(y*50), 50, 50, color)
+				ui.DrawTerrain(world.IVector2{X: x, Y: y}, cell)
 			}
 		}
 
@@ -302,6 +370,10 @@ func main() {
 			ui.DrawPortal(portal)
 		}
 
+		for _, pad := range gs.Teleporters {
+			ui.DrawTeleporter(pad)
+		}
+
 		// Then draw explosions on top
 		for _, explosion := range gs.Explosions {
 			alpha := float32(explosion.Timer) / float32(explosion.InitialTimer)
@@ -309,12 +381,17 @@ func main() {
 			rl.DrawRectangle(int32(explosion.Position.X*50), int32(explosion.Position.Y*50), 50, 50, color)
 		}
 
+		// Noise rings spreading from their sources
+		for _, noise := range gs.Noises {
+			ui.DrawNoise(noise)
+		}
+
 		for _, item := range gs.Items {
 			if item.Kind != entities.ItemNone {
 				var color rl.Color
 				switch item.Kind {
 				case entities.ItemKey:
-					color = palette.Colors["COLOR_DOORKEY"]
+					color = item.Color.Color()
 					rl.DrawCircle(int32(item.Position.X*50+25), int32(item.Position.Y*50+25), 20, color)
 				case entities.ItemBombRefill:
 					// Show dimmed bomb and cooldown timer if on cooldown
@@ -332,9 +409,32 @@ func main() {
 				case entities.ItemBombSlot:
 					color = palette.Colors["COLOR_DOORKEY"]
 					rl.DrawCircle(int32(item.Position.X*50+25), int32(item.Position.Y*50+25), 20, color)
+				case entities.ItemBombRemote, entities.ItemBombLongFuse, entities.ItemBombCross:
+					kind, _ := item.Kind.SpecialBombKind()
+					ui.DrawBombIcon(kind, rl.NewVector2(float32(item.Position.X*50+25), float32(item.Position.Y*50+25)), 20)
+				case entities.ItemPowerBlastRange, entities.ItemPowerShortFuse, entities.ItemPowerKick, entities.ItemPowerImmunity:
+					ui.DrawPowerUp(item.Kind, rl.NewVector2(float32(item.Position.X*50+25), float32(item.Position.Y*50+25)))
 				case entities.ItemCheckpoint:
 					color = palette.Colors["COLOR_CHECKPOINT"]
 					rl.DrawCircle(int32(item.Position.X*50+25), int32(item.Position.Y*50+25), 20, color)
+				case entities.ItemHeal:
+					// Draw a plus sign
+					color = palette.Colors["COLOR_HEALTHBAR"]
+					rl.DrawRectangle(int32(item.Position.X*50+20), int32(item.Position.Y*50+8), 10, 34, color)
+					rl.DrawRectangle(int32(item.Position.X*50+8), int32(item.Position.Y*50+20), 34, 10, color)
+				}
+			}
+		}
+
+		for _, block := range gs.Blocks {
+			ui.DrawBlock(block, gs.TurnAnimation)
+		}
+
+		// Draw guard vision cones below the eepers
+		if gs.Settings.ShowVisionCones || gs.Debug.Enabled {
+			for _, eeper := range gs.Eepers {
+				if !eeper.Dead && (eeper.Kind == entities.EeperGuard || eeper.Kind == entities.EeperMother || eeper.Kind == entities.EeperBoss || eeper.Kind == entities.EeperBomber) {
+					ui.DrawEeperVisionCone(gs, eeper)
 				}
 			}
 		}
@@ -352,14 +452,23 @@ func main() {
 				color = palette.Colors["COLOR_MOTHER"]
 			case entities.EeperGnome:
 				color = palette.Colors["COLOR_DOORKEY"]
+				if eeper.Thief {
+					color = palette.Colors["COLOR_THIEF"]
+				}
 			case entities.EeperFather:
 				color = palette.Colors["COLOR_FATHER"]
+			case entities.EeperBoss:
+				color = palette.Colors["COLOR_BOSS"]
+			case entities.EeperBomber:
+				color = palette.Colors["COLOR_BOMBER"]
+			case entities.EeperMirror:
+				color = palette.Colors["COLOR_MIRROR"]
 			}
 
 			// Interpolate eeper position for smooth movement
 			eeperPrevPos := rl.NewVector2(float32(eeper.PrevPosition.X*50), float32(eeper.PrevPosition.Y*50))
 			eeperPos := rl.NewVector2(float32(eeper.Position.X*50), float32(eeper.Position.Y*50))
-			eeperInterpPos := rl.Vector2Lerp(eeperPos, eeperPrevPos, gs.TurnAnimation)
+			eeperInterpPos := rl.Vector2Lerp(eeperPos, eeperPrevPos, gs.EeperAnimation())
 			eeperSize := rl.NewVector2(float32(eeper.Size.X*50), float32(eeper.Size.Y*50))
 
 			// Gnomes are rendered smaller (70% size) and centered
@@ -372,39 +481,55 @@ func main() {
 				renderPos = rl.Vector2Add(eeperInterpPos, offset)
 			}
 
+			// Eepers split off a mother grow to full size while sliding out of her
+			if eeper.Spawning {
+				growRatio := 1.0 - gs.EeperAnimation()*0.7
+				grownSize := rl.NewVector2(renderSize.X*growRatio, renderSize.Y*growRatio)
+				offset := rl.NewVector2((renderSize.X-grownSize.X)*0.5, (renderSize.Y-grownSize.Y)*0.5)
+				renderPos = rl.Vector2Add(renderPos, offset)
+				renderSize = grownSize
+			}
+
 			// Draw eeper body
 			rl.DrawRectangleV(renderPos, renderSize, color)
 
-			// Draw health bar for guards and mothers
-			if eeper.Kind == entities.EeperGuard || eeper.Kind == entities.EeperMother {
-				ui.DrawEeperHealthBar(eeper, eeperInterpPos, eeperSize)
+			// Draw health bar, cooldown and alert for guards, mothers, bosses and bombers
+			if eeper.Kind == entities.EeperGuard || eeper.Kind == entities.EeperMother || eeper.Kind == entities.EeperBoss || eeper.Kind == entities.EeperBomber {
+				// Bosses get a large health bar in screen space instead
+				if eeper.Kind != entities.EeperBoss {
+					ui.DrawEeperHealthBar(eeper, eeperInterpPos, eeperSize)
+				}
 
-				// Draw cooldown bubble only when the eeper can see the player (path >= 0)
-				if eeper.Path != nil && eeper.Position.Y >= 0 && eeper.Position.Y < len(eeper.Path) &&
-					eeper.Position.X >= 0 && eeper.Position.X < len(eeper.Path[0]) &&
-					eeper.Path[eeper.Position.Y][eeper.Position.X] >= 0 {
+				// Draw cooldown bubble only while the eeper is chasing the player
+				if eeper.Behaviour == entities.BehaviourChase {
 					ui.DrawEeperCooldownBubble(eeper, eeperInterpPos, eeperSize, color)
 				}
+				ui.DrawEeperAlertMeter(eeper, eeperInterpPos, eeperSize)
 			}
 
 			// Draw eeper eyes (use renderPos and renderSize for gnomes)
-			ui.DrawEeperEyes(eeper, renderPos, gs.TurnAnimation)
+			ui.DrawEeperEyes(eeper, renderPos, gs.EeperAnimation())
 		}
 
 		playerPrevPos := rl.NewVector2(float32(gs.Player.PrevPosition.X*50), float32(gs.Player.PrevPosition.Y*50))
 		playerPos := rl.NewVector2(float32(gs.Player.Position.X*50), float32(gs.Player.Position.Y*50))
 		interpPos := rl.Vector2Lerp(playerPos, playerPrevPos, gs.TurnAnimation)
-		rl.DrawRectangleV(interpPos, rl.NewVector2(50, 50), palette.Colors["COLOR_PLAYER"])
+		playerColor := palette.Colors["COLOR_PLAYER"]
+		// Blink while invulnerable after being hit
+		if gs.Player.InvulnerableTurns > 0 && int(rl.GetTime()*8)%2 == 0 {
+			playerColor = rl.Fade(playerColor, 0.4)
+		}
+		rl.DrawRectangleV(interpPos, rl.NewVector2(50, 50), playerColor)
 		ui.DrawPlayerEyes(gs.Player, interpPos)
 
 		// Draw bombs AFTER player so they appear on top
 		for _, bomb := range gs.Bombs {
-			rl.DrawCircle(int32(bomb.Position.X*50+25), int32(bomb.Position.Y*50+25), 20, palette.Colors["COLOR_BOMB"])
-			countdownText := fmt.Sprintf("%d", bomb.Countdown)
-			textWidth := rl.MeasureText(countdownText, 20)
-			rl.DrawText(countdownText, int32(bomb.Position.X*50+25)-textWidth/2, int32(bomb.Position.Y*50+15), 20, rl.White)
+			ui.DrawBomb(bomb, gs.BombTimeLeft(bomb), gs.RealTimeEnabled())
 		}
 
+		// Draw debug overlay on top of the world
+		ui.DrawDebugOverlay(gs)
+
 		if gs.Player.Dead {
 			rl.DrawText("YOU DIED", screenWidth/2-100, screenHeight/2-50, 50, rl.Red)
 		}
@@ -416,9 +541,14 @@ func main() {
 
 		// Draw UI in screen space (outside of Mode2D)
 		ui.DrawUI(gs, screenWidth)
+		ui.DrawBossHealthBars(gs, screenWidth, screenHeight)
+		ui.DrawDebugPanel(gs, screenWidth)
 
 		// Draw menu on top of everything
-		gs.Menu.DrawMenu(gs.InHub)
+		gs.Menu.DrawMenu(gs.InHub, gs.Settings)
+
+		// Draw developer console above the menu
+		devConsole.Draw(screenWidth, screenHeight)
 
 		rl.EndDrawing()
 
@@ -435,6 +565,12 @@ func main() {
 				gs.TurnAnimation = 0
 			}
 		}
+		if gs.WorldAnimation > 0 {
+			gs.WorldAnimation -= rl.GetFrameTime() * 10
+			if gs.WorldAnimation < 0 {
+				gs.WorldAnimation = 0
+			}
+		}
 	}
 }
 
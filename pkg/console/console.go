@@ -0,0 +1,131 @@
+package console
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const maxOutputLines = 500 // Oldest output lines are dropped beyond this
+
+// Command is a single console command.
+type Command struct {
+	Name        string                              // Name typed to invoke the command
+	Usage       string                              // Argument synopsis shown by help, e.g. "<x> <y>"
+	Description string                              // One-line description shown by help
+	Run         func(args []string) (string, error) // Executes the command with its arguments
+}
+
+// Console is a drop-down developer console with an extensible command registry.
+type Console struct {
+	IsOpen       bool
+	Input        string   // Line currently being typed
+	Output       []string // Lines printed so far (oldest first)
+	Scroll       int      // Number of lines scrolled up from the bottom
+	History      []string // Previously executed lines (oldest first)
+	HistoryIndex int      // Position while browsing history (len(History) = not browsing)
+	commands     map[string]Command
+}
+
+// New creates a console with the built-in help and clear commands registered
+func New() *Console {
+	c := &Console{
+		commands: make(map[string]Command),
+	}
+
+	c.Register(Command{
+		Name:        "help",
+		Usage:       "[command]",
+		Description: "List commands or show usage of a command",
+		Run:         c.help,
+	})
+	c.Register(Command{
+		Name:        "clear",
+		Description: "Clear the console output",
+		Run: func(args []string) (string, error) {
+			c.Output = nil
+			c.Scroll = 0
+			return "", nil
+		},
+	})
+
+	return c
+}
+
+// Register adds a command to the console, replacing any command with the same name
+func (c *Console) Register(cmd Command) {
+	c.commands[strings.ToLower(cmd.Name)] = cmd
+}
+
+// Toggle opens or closes the console
+func (c *Console) Toggle() {
+	c.IsOpen = !c.IsOpen
+	c.Input = ""
+	c.HistoryIndex = len(c.History)
+}
+
+// Print appends a message to the console output, one line per newline
+func (c *Console) Print(format string, args ...any) {
+	text := fmt.Sprintf(format, args...)
+	c.Output = append(c.Output, strings.Split(text, "\n")...)
+	if len(c.Output) > maxOutputLines {
+		c.Output = c.Output[len(c.Output)-maxOutputLines:]
+	}
+	// Jump back to the newest output
+	c.Scroll = 0
+}
+
+// Execute parses and runs a command line, printing its output
+func (c *Console) Execute(line string) {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return
+	}
+
+	c.History = append(c.History, line)
+	c.HistoryIndex = len(c.History)
+	c.Print("> %s", line)
+
+	fields := strings.Fields(line)
+	cmd, found := c.commands[strings.ToLower(fields[0])]
+	if !found {
+		c.Print("Unknown command %q (type help for a list of commands)", fields[0])
+		return
+	}
+
+	output, err := cmd.Run(fields[1:])
+	if err != nil {
+		c.Print("Error: %v", err)
+		if cmd.Usage != "" {
+			c.Print("Usage: %s %s", cmd.Name, cmd.Usage)
+		}
+		return
+	}
+	if output != "" {
+		c.Print("%s", output)
+	}
+}
+
+// help lists all registered commands or describes a single one
+func (c *Console) help(args []string) (string, error) {
+	if len(args) > 0 {
+		cmd, found := c.commands[strings.ToLower(args[0])]
+		if !found {
+			return "", fmt.Errorf("unknown command %q", args[0])
+		}
+		return fmt.Sprintf("%s %s - %s", cmd.Name, cmd.Usage, cmd.Description), nil
+	}
+
+	names := make([]string, 0, len(c.commands))
+	for name := range c.commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	lines := make([]string, 0, len(names))
+	for _, name := range names {
+		cmd := c.commands[name]
+		lines = append(lines, fmt.Sprintf("%s %s - %s", cmd.Name, cmd.Usage, cmd.Description))
+	}
+	return strings.Join(lines, "\n"), nil
+}
@@ -0,0 +1,113 @@
+package console
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+const (
+	fontSize   = int32(20)
+	lineHeight = int32(22)
+)
+
+// Update processes keyboard input for the console while it is open
+func (c *Console) Update() {
+	if !c.IsOpen {
+		return
+	}
+
+	// Typed characters (the backtick toggles the console and is never inserted)
+	for char := rl.GetCharPressed(); char > 0; char = rl.GetCharPressed() {
+		if char == '`' || char == '~' {
+			continue
+		}
+		if char >= 32 && char < 127 {
+			c.Input += string(char)
+		}
+	}
+
+	if (rl.IsKeyPressed(rl.KeyBackspace) || rl.IsKeyPressedRepeat(rl.KeyBackspace)) && len(c.Input) > 0 {
+		c.Input = c.Input[:len(c.Input)-1]
+	}
+
+	if rl.IsKeyPressed(rl.KeyEnter) || rl.IsKeyPressed(rl.KeyKpEnter) {
+		c.Execute(c.Input)
+		c.Input = ""
+	}
+
+	// Browse command history
+	if rl.IsKeyPressed(rl.KeyUp) && c.HistoryIndex > 0 {
+		c.HistoryIndex--
+		c.Input = c.History[c.HistoryIndex]
+	}
+	if rl.IsKeyPressed(rl.KeyDown) && c.HistoryIndex < len(c.History) {
+		c.HistoryIndex++
+		if c.HistoryIndex == len(c.History) {
+			c.Input = ""
+		} else {
+			c.Input = c.History[c.HistoryIndex]
+		}
+	}
+
+	// Scroll output with page keys or the mouse wheel
+	if rl.IsKeyPressed(rl.KeyPageUp) {
+		c.scrollBy(10)
+	}
+	if rl.IsKeyPressed(rl.KeyPageDown) {
+		c.scrollBy(-10)
+	}
+	if wheel := rl.GetMouseWheelMove(); wheel != 0 {
+		c.scrollBy(int(wheel * 3))
+	}
+
+	if rl.IsKeyPressed(rl.KeyEscape) {
+		c.Toggle()
+	}
+}
+
+// scrollBy scrolls the output by the given number of lines (positive = up)
+func (c *Console) scrollBy(lines int) {
+	c.Scroll += lines
+	if c.Scroll > len(c.Output)-1 {
+		c.Scroll = len(c.Output) - 1
+	}
+	if c.Scroll < 0 {
+		c.Scroll = 0
+	}
+}
+
+// Draw renders the console at the top of the screen
+func (c *Console) Draw(screenWidth, screenHeight int32) {
+	if !c.IsOpen {
+		return
+	}
+
+	consoleHeight := screenHeight / 2
+	rl.DrawRectangle(0, 0, screenWidth, consoleHeight, rl.Fade(rl.Black, 0.85))
+	rl.DrawLine(0, consoleHeight, screenWidth, consoleHeight, rl.LightGray)
+
+	// Input line at the bottom of the console
+	inputY := consoleHeight - lineHeight - 5
+	cursor := ""
+	if int(rl.GetTime()*2)%2 == 0 {
+		cursor = "_"
+	}
+	rl.DrawText("> "+c.Input+cursor, 10, inputY, fontSize, rl.White)
+
+	// Output lines above the input, newest at the bottom
+	visibleLines := int((inputY - 10) / lineHeight)
+	end := len(c.Output) - c.Scroll
+	start := end - visibleLines
+	if start < 0 {
+		start = 0
+	}
+	for i := start; i < end; i++ {
+		y := inputY - int32(end-i)*lineHeight
+		rl.DrawText(c.Output[i], 10, y, fontSize, rl.LightGray)
+	}
+
+	if c.Scroll > 0 {
+		scrollText := "-- scrolled --"
+		textWidth := rl.MeasureText(scrollText, fontSize)
+		rl.DrawText(scrollText, screenWidth-textWidth-10, inputY, fontSize, rl.Yellow)
+	}
+}
@@ -0,0 +1,9 @@
+package entities
+
+import "github.com/engpetarmarinov/eepers-go/pkg/world"
+
+// BlockState represents a pushable block. The map holds a CellBlock at its position.
+type BlockState struct {
+	PrevPosition world.IVector2
+	Position     world.IVector2
+}
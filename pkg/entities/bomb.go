@@ -2,8 +2,38 @@ package entities
 
 import "github.com/engpetarmarinov/eepers-go/pkg/world"
 
+// BombKind represents the type of bomb.
+type BombKind int
+
+const (
+	BombNormal   BombKind = iota
+	BombRemote            // Waits for the player to detonate it
+	BombLongFuse          // Takes longer to explode
+	BombCross             // Explodes diagonally instead of straight
+	BombKindCount
+)
+
+// String returns a human-readable name for the bomb kind.
+func (k BombKind) String() string {
+	switch k {
+	case BombNormal:
+		return "Normal"
+	case BombRemote:
+		return "Remote"
+	case BombLongFuse:
+		return "Long fuse"
+	case BombCross:
+		return "Cross"
+	default:
+		return "Unknown"
+	}
+}
+
 // BombState represents the state of a bomb.
 type BombState struct {
 	Position  world.IVector2
 	Countdown int
+	Kind      BombKind
+	Range     int  // Cells the explosion reaches in each direction (0 = rules default)
+	FromBoss  bool // Thrown by a boss, whose own blasts cannot hurt it
 }
@@ -1,6 +1,11 @@
 package entities
 
-import "github.com/engpetarmarinov/eepers-go/pkg/world"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+)
 
 // EeperKind represents the type of eeper.
 type EeperKind int
@@ -10,21 +15,125 @@ const (
 	EeperMother
 	EeperGnome
 	EeperFather
+	EeperBoss
+	EeperBomber
+	EeperMirror
+	eeperKindCount
+)
+
+// String returns a human-readable name for the eeper kind.
+func (k EeperKind) String() string {
+	switch k {
+	case EeperGuard:
+		return "Guard"
+	case EeperMother:
+		return "Mother"
+	case EeperGnome:
+		return "Gnome"
+	case EeperFather:
+		return "Father"
+	case EeperBoss:
+		return "Boss"
+	case EeperBomber:
+		return "Bomber"
+	case EeperMirror:
+		return "Mirror"
+	default:
+		return "Unknown"
+	}
+}
+
+// ParseEeperKind returns the eeper kind with the given (case-insensitive) name.
+func ParseEeperKind(name string) (EeperKind, error) {
+	for k := EeperKind(0); k < eeperKindCount; k++ {
+		if strings.EqualFold(k.String(), name) {
+			return k, nil
+		}
+	}
+	return EeperGuard, fmt.Errorf("unknown eeper kind %q", name)
+}
+
+// EeperBehaviour represents what an eeper is currently doing.
+type EeperBehaviour int
+
+const (
+	BehaviourSleep EeperBehaviour = iota
+	BehaviourPatrol
+	BehaviourChase
+	BehaviourInvestigate
+	BehaviourFlee
+	BehaviourSearch  // Looking around where the player was last seen
+	BehaviourReturn  // Walking back to its post after giving up the search
+	BehaviourAttack  // Hitting the player it stands on, lasts a single turn
+	BehaviourStunned // Kept from acting by a status effect, lasts a single turn
+	BehaviourAlert   // Stares at the player while its alert meter fills up
 )
 
+// String returns a human-readable name for the behaviour.
+func (b EeperBehaviour) String() string {
+	switch b {
+	case BehaviourSleep:
+		return "Sleep"
+	case BehaviourPatrol:
+		return "Patrol"
+	case BehaviourChase:
+		return "Chase"
+	case BehaviourInvestigate:
+		return "Investigate"
+	case BehaviourFlee:
+		return "Flee"
+	case BehaviourSearch:
+		return "Search"
+	case BehaviourReturn:
+		return "Return"
+	case BehaviourAttack:
+		return "Attack"
+	case BehaviourStunned:
+		return "Stunned"
+	case BehaviourAlert:
+		return "Alert"
+	default:
+		return "Unknown"
+	}
+}
+
 // EeperState represents the state of an eeper.
 type EeperState struct {
-	Kind           EeperKind
-	Dead           bool
-	Position       world.IVector2
-	PrevPosition   world.IVector2
-	EyesAngle      float32
-	EyesTarget     world.IVector2
-	PrevEyes       EyesKind
-	Eyes           EyesKind
-	Size           world.IVector2
-	Path           [][]int // Distance map for pathfinding (-1 = unreachable, 0 = player position, >0 = steps to player)
-	Damaged        bool
-	Health         float32
-	AttackCooldown int
+	Kind              EeperKind
+	Dead              bool
+	Position          world.IVector2
+	PrevPosition      world.IVector2
+	EyesAngle         float32
+	EyesTarget        world.IVector2
+	PrevEyes          EyesKind
+	Eyes              EyesKind
+	Size              world.IVector2
+	Path              [][]int // Distance map for pathfinding (-1 = unreachable, 0 = player position, >0 = steps to player)
+	Damaged           bool
+	Grazed            bool // Caught only by the edge of an explosion this turn
+	Health            float32
+	AttackCooldown    int // Turns until a guard moves again, or a bomber throws its next bomb
+	Behaviour         EeperBehaviour
+	Patrol            []world.IVector2         // Patrol waypoints (top-left positions) walked in a loop while the player is unreachable
+	PatrolIndex       int                      // Index of the waypoint the eeper is walking to
+	Alert             float32                  // 0..1, rises while the eeper sees the player; chases at 1
+	LastKnownPosition world.IVector2           // Where the player was last seen (or a noise was heard)
+	SearchTurns       int                      // Turns left looking around the last known position
+	Post              world.IVector2           // Where the eeper was spawned, returned to after a search
+	KeyColor          world.KeyColor           // Colour of the key a gnome drops when killed
+	BossPhase         int                      // Index of a boss's current phase in the level's boss config
+	SpawnCooldown     int                      // Turns until a boss spawns its next gnome
+	ThrowCooldown     int                      // Turns until a boss throws its next bomb
+	MirrorFlipX       bool                     // Mirror moves right when the player moves left and vice versa
+	MirrorFlipY       bool                     // Mirror moves down when the player moves up and vice versa
+	Thief             bool                     // Gnome steals the keys and bomb refills it walks over
+	Loot              []Item                   // Items stolen by a thief gnome, dropped when it is killed
+	ChildKind         EeperKind                // Kind of the eepers a mother splits into
+	ChildCount        int                      // How many eepers a mother splits into
+	Spawning          bool                     // Split off a mother this turn, grows out of her while the turn animates
+	Keys              [world.KeyColorCount]int // Keys the eeper carries to open doors in its way
+	BreaksBarricades  bool                     // Eeper smashes barricades in its way
+	BreakProgress     int                      // Turns spent smashing the current barricade
+	BreakTarget       world.IVector2           // Barricade cell the eeper is smashing
+	Effects           [StatusEffectCount]int   // Turns left of each status effect
 }
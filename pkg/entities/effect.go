@@ -0,0 +1,25 @@
+package entities
+
+// StatusEffect represents a temporary condition of an eeper.
+type StatusEffect int
+
+const (
+	EffectStunned StatusEffect = iota // Skips its turns
+	EffectSlowed                      // Acts only every other turn
+	EffectEnraged                     // Acts without waiting for its cooldown
+	StatusEffectCount
+)
+
+// String returns a human-readable name for the status effect.
+func (e StatusEffect) String() string {
+	switch e {
+	case EffectStunned:
+		return "Stunned"
+	case EffectSlowed:
+		return "Slowed"
+	case EffectEnraged:
+		return "Enraged"
+	default:
+		return "Unknown"
+	}
+}
@@ -9,4 +9,28 @@ const (
 	EyesAngry
 	EyesCringe
 	EyesSurprised
+	EyesSearching
+	EyesDizzy
 )
+
+// String returns a human-readable name for the eyes state.
+func (e EyesKind) String() string {
+	switch e {
+	case EyesOpen:
+		return "Open"
+	case EyesClosed:
+		return "Closed"
+	case EyesAngry:
+		return "Angry"
+	case EyesCringe:
+		return "Cringe"
+	case EyesSurprised:
+		return "Surprised"
+	case EyesSearching:
+		return "Searching"
+	case EyesDizzy:
+		return "Dizzy"
+	default:
+		return "Unknown"
+	}
+}
@@ -36,4 +36,16 @@ var EyesMeshes = map[EyesKind]EyesMesh{
 		// Right Eye
 		EyeMesh{{X: 0.0, Y: 0.3}, {X: 0.0, Y: 1.0}, {X: 1.0, Y: 0.3}, {X: 1.0, Y: 1.0}},
 	},
+	EyesSearching: {
+		// Left Eye
+		EyeMesh{{X: 0.0, Y: 0.5}, {X: 0.0, Y: 1.0}, {X: 1.0, Y: 0.4}, {X: 1.0, Y: 0.9}},
+		// Right Eye
+		EyeMesh{{X: 0.0, Y: 0.4}, {X: 0.0, Y: 0.9}, {X: 1.0, Y: 0.5}, {X: 1.0, Y: 1.0}},
+	},
+	EyesDizzy: {
+		// Left Eye
+		EyeMesh{{X: 0.0, Y: 0.1}, {X: 0.3, Y: 1.0}, {X: 0.8, Y: 0.4}, {X: 1.0, Y: 0.8}},
+		// Right Eye
+		EyeMesh{{X: 0.2, Y: 0.6}, {X: 0.0, Y: 1.0}, {X: 1.0, Y: 0.0}, {X: 0.7, Y: 0.9}},
+	},
 }
@@ -11,11 +11,35 @@ const (
 	ItemBombRefill
 	ItemCheckpoint
 	ItemBombSlot
+	ItemHeal
+	ItemBombRemote
+	ItemBombLongFuse
+	ItemBombCross
+	ItemPowerBlastRange // Power-up: bombs reach one cell further
+	ItemPowerShortFuse  // Power-up: bombs explode one turn sooner
+	ItemPowerKick       // Power-up: planted bombs can be kicked
+	ItemPowerImmunity   // Power-up: temporary explosion immunity
 )
 
+// SpecialBombKind returns the kind of bomb a special bomb item gives, and
+// whether the item is a special bomb at all.
+func (k ItemKind) SpecialBombKind() (BombKind, bool) {
+	switch k {
+	case ItemBombRemote:
+		return BombRemote, true
+	case ItemBombLongFuse:
+		return BombLongFuse, true
+	case ItemBombCross:
+		return BombCross, true
+	default:
+		return BombNormal, false
+	}
+}
+
 // Item represents an item in the game.
 type Item struct {
 	Kind     ItemKind
 	Position world.IVector2
 	Cooldown int
+	Color    world.KeyColor // Colour of a key item
 }
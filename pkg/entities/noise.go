@@ -0,0 +1,11 @@
+package entities
+
+import "github.com/engpetarmarinov/eepers-go/pkg/world"
+
+// NoiseState represents a noise ring spreading from its source (visual only).
+type NoiseState struct {
+	Position     world.IVector2
+	Radius       int
+	Timer        int
+	InitialTimer int
+}
@@ -4,20 +4,26 @@ import "github.com/engpetarmarinov/eepers-go/pkg/world"
 
 // PlayerState represents the state of the player.
 type PlayerState struct {
-	PrevPosition     world.IVector2
-	Position         world.IVector2
-	PrevEyes         EyesKind
-	Eyes             EyesKind
-	EyesTarget       world.IVector2
-	Keys             int
-	Bombs            int
-	BombSlots        int
-	Health           float32
-	Dead             bool
-	DeathTime        float64
-	ReachedFather    bool    // Victory condition - player reached Father
-	VictoryTime      float64 // Time when victory was achieved
-	EnteringPortal   bool    // Player is entering a portal
-	PortalEntryTime  float64 // Time when portal entry started
-	PortalToActivate int     // Which portal to activate after animation
+	PrevPosition      world.IVector2
+	Position          world.IVector2
+	PrevEyes          EyesKind
+	Eyes              EyesKind
+	EyesTarget        world.IVector2
+	Moves             []world.IVector2         // Directions of the moves the player tried since the last world turn, copied by mirrors
+	Keys              [world.KeyColorCount]int // Keys held per colour
+	Bombs             int
+	BombSlots         int
+	SpecialBombs      [BombKindCount]int // Special bombs held per kind (normal bombs use Bombs)
+	SelectedBomb      BombKind           // Kind of bomb planted next
+	Modifiers         PlayerModifiers    // Bonuses from collected power-ups
+	Health            float32
+	InvulnerableTurns int  // Turns left during which the player cannot be damaged
+	Sprinting         bool // Player is running, making noise with every step
+	Dead              bool
+	DeathTime         float64
+	ReachedFather     bool    // Victory condition - player reached Father
+	VictoryTime       float64 // Time when victory was achieved
+	EnteringPortal    bool    // Player is entering a portal
+	PortalEntryTime   float64 // Time when portal entry started
+	PortalToActivate  int     // Which portal to activate after animation
 }
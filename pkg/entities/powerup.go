@@ -0,0 +1,9 @@
+package entities
+
+// PlayerModifiers holds the bonuses collected from power-up items.
+type PlayerModifiers struct {
+	BlastRange    int  // Extra cells the player's bomb explosions reach
+	FuseReduction int  // Turns taken off the countdown of the player's bombs
+	Kick          bool // Walking into a planted bomb kicks it until it hits an obstacle
+	ImmunityTurns int  // Turns left during which explosions don't hurt the player
+}
@@ -0,0 +1,11 @@
+package entities
+
+import "github.com/engpetarmarinov/eepers-go/pkg/world"
+
+// TeleporterState represents a teleporter pad linked to the other pad with the same ID
+type TeleporterState struct {
+	ID       int            // Pair ID (1-4), shared by the two linked pads
+	Position world.IVector2 // Cell of the pad
+	Link     int            // Index of the linked pad in the teleporter list
+	Cooldown int            // Turns until the pad can be used again
+}
@@ -0,0 +1,11 @@
+package entities
+
+import "github.com/engpetarmarinov/eepers-go/pkg/world"
+
+// WireState links trigger cells (plates and levers) to the gate cells they toggle.
+type WireState struct {
+	Triggers  []world.IVector2
+	Gates     []world.IVector2
+	Pressed   []bool // Whether each trigger was held down at the last update
+	GatesOpen []bool // Whether each gate should be open (closing waits until the gate is free)
+}
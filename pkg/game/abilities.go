@@ -0,0 +1,130 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/audio"
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// assignAbilities gives the keys and barricade breaking configured for the
+// level to the eepers spawned at the configured positions
+func (gs *State) assignAbilities() error {
+	for _, config := range gs.LevelConfig.Abilities {
+		eeper := gs.eeperAt(config.Eeper, entities.EeperGuard, entities.EeperMother)
+		if eeper == nil {
+			return fmt.Errorf("level %s: no guard at %d,%d for abilities", gs.CurrentLevelPath, config.Eeper.X, config.Eeper.Y)
+		}
+		for _, color := range config.Keys {
+			eeper.Keys[color]++
+		}
+		eeper.BreaksBarricades = config.BreaksBarricades
+	}
+
+	return nil
+}
+
+// eeperCanClear checks whether the eeper is able to get through a cell that
+// blocks it: a door it has a key for or a barricade it can smash
+func (gs *State) eeperCanClear(eeper *entities.EeperState, cell world.Cell) bool {
+	switch {
+	case cell.IsDoor():
+		return eeper.Keys[cell.DoorColor()] > 0
+	case cell == world.CellBarricade:
+		return eeper.BreaksBarricades
+	default:
+		return false
+	}
+}
+
+// clearEeperWay walks the eeper up to a door or barricade blocking its way to
+// the player, and opens or works on it once the eeper stands next to it. Each
+// direction is walked the way GuardMoveCandidates does, since the distance
+// map gives a whole straight corridor the same distance, the door or
+// barricade included.
+func (gs *State) clearEeperWay(eeper *entities.EeperState) {
+	currentDist := eeper.Path[eeper.Position.Y][eeper.Position.X]
+	if currentDist <= 0 {
+		eeper.BreakProgress = 0
+		return
+	}
+
+	canPass := func(cell world.Cell) bool {
+		return cell.IsWalkable() || cell == world.CellExplosion || gs.eeperCanClear(eeper, cell)
+	}
+
+	for _, dir := range Directions {
+		pos := eeper.Position
+		for {
+			next := pos.Add(dir)
+			if !gs.WithinMap(next) {
+				break
+			}
+
+			if gs.eeperCanStandHere(next, eeper) {
+				pos = next
+				if eeper.Path[pos.Y][pos.X] == 0 {
+					break // Nothing in the way up to the player
+				}
+				continue
+			}
+
+			// The first footprint that does not fit must be blocked only by
+			// something the eeper can clear, and lead on to the player
+			dist := eeper.Path[next.Y][next.X]
+			if dist < 0 || dist > currentDist || !gs.eeperFits(next, eeper, canPass) {
+				break
+			}
+
+			if pos != eeper.Position {
+				// Jump up to it first, it is cleared in the coming turns
+				eeper.Position = pos
+				eeper.BreakProgress = 0
+				rl.PlaySound(audio.GuardStepSound)
+				return
+			}
+			if gs.clearFootprint(eeper, next) {
+				return
+			}
+			break
+		}
+	}
+
+	// Nothing to smash this turn
+	eeper.BreakProgress = 0
+}
+
+// clearFootprint opens the first door or works on the first barricade the
+// eeper can clear within its footprint at pos
+func (gs *State) clearFootprint(eeper *entities.EeperState, pos world.IVector2) bool {
+	for y := pos.Y; y < pos.Y+eeper.Size.Y; y++ {
+		for x := pos.X; x < pos.X+eeper.Size.X; x++ {
+			cellPos := world.IVector2{X: x, Y: y}
+			cell := gs.Map[y][x]
+			switch {
+			case cell.IsDoor() && eeper.Keys[cell.DoorColor()] > 0:
+				eeper.Keys[cell.DoorColor()]--
+				gs.RemoveDoor(cellPos)
+				rl.PlaySound(audio.OpenDoorSound)
+				return true
+			case cell == world.CellBarricade && eeper.BreaksBarricades:
+				// Smashing another barricade starts over
+				if eeper.BreakTarget != cellPos {
+					eeper.BreakTarget = cellPos
+					eeper.BreakProgress = 0
+				}
+				eeper.BreakProgress++
+				if eeper.BreakProgress >= gs.Rules.BarricadeBreakTurns {
+					eeper.BreakProgress = 0
+					gs.FloodFill(cellPos, world.CellBarricade, world.CellExplosion)
+					gs.EmitNoise(cellPos, gs.Rules.DoorNoiseRadius)
+					rl.PlaySound(audio.BlastSound)
+				}
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+)
+
+// corridorState builds a walled corridor three cells high with a wall of the
+// given cell across it at x=6, a guard at guardX and the player at its right end
+func corridorState(blocker world.Cell, guardX int) *State {
+	const width, height = 12, 5
+
+	gs := &State{Rules: DefaultRules()}
+	gs.Map = make([][]world.Cell, height)
+	for y := range gs.Map {
+		gs.Map[y] = make([]world.Cell, width)
+		for x := range gs.Map[y] {
+			switch {
+			case y == 0 || y == height-1 || x == 0 || x == width-1:
+				gs.Map[y][x] = world.CellWall
+			case x == 6:
+				gs.Map[y][x] = blocker
+			default:
+				gs.Map[y][x] = world.CellFloor
+			}
+		}
+	}
+
+	gs.Player.Position = world.IVector2{X: 10, Y: 2}
+	gs.SpawnGuard(world.IVector2{X: guardX, Y: 1})
+	return gs
+}
+
+// nextToBlocker is where the guard's footprint ends right before the blocker at x=6
+const nextToBlocker = 3
+
+func TestClearEeperWayOpensDoorInCorridor(t *testing.T) {
+	gs := corridorState(world.CellDoor, nextToBlocker)
+	guard := &gs.Eepers[0]
+	guard.Keys[world.KeyCyan] = 1
+
+	gs.recomputePathForEeper(guard)
+	if len(gs.GuardMoveCandidates(guard)) != 0 {
+		t.Fatalf("guard should not be able to move before the door is opened")
+	}
+
+	gs.clearEeperWay(guard)
+
+	for y := 1; y <= 3; y++ {
+		if cell := gs.Map[y][6]; cell != world.CellFloor {
+			t.Errorf("door cell at 6,%d is %v after clearEeperWay, want floor", y, cell)
+		}
+	}
+	if guard.Keys[world.KeyCyan] != 0 {
+		t.Errorf("guard has %d cyan keys left, want 0", guard.Keys[world.KeyCyan])
+	}
+}
+
+func TestClearEeperWayWalksUpToDistantDoor(t *testing.T) {
+	gs := corridorState(world.CellDoor, 1)
+	guard := &gs.Eepers[0]
+	guard.Keys[world.KeyCyan] = 1
+
+	gs.recomputePathForEeper(guard)
+	gs.clearEeperWay(guard)
+
+	if cell := gs.Map[2][6]; cell != world.CellDoor {
+		t.Errorf("distant door cell is %v after clearEeperWay, want door", cell)
+	}
+	if guard.Position.X != nextToBlocker {
+		t.Errorf("guard at x=%d, want it next to the door at x=%d", guard.Position.X, nextToBlocker)
+	}
+}
+
+func TestClearEeperWaySmashesBarricadeNextToIt(t *testing.T) {
+	gs := corridorState(world.CellBarricade, nextToBlocker)
+	guard := &gs.Eepers[0]
+	guard.BreaksBarricades = true
+
+	gs.recomputePathForEeper(guard)
+	for turn := 0; turn < gs.Rules.BarricadeBreakTurns; turn++ {
+		if gs.Map[2][6] != world.CellBarricade {
+			t.Fatalf("barricade smashed after %d turns, want %d", turn, gs.Rules.BarricadeBreakTurns)
+		}
+		gs.clearEeperWay(guard)
+	}
+
+	if cell := gs.Map[2][6]; cell == world.CellBarricade {
+		t.Errorf("barricade still standing after %d turns", gs.Rules.BarricadeBreakTurns)
+	}
+}
+
+func TestClearEeperWayLeavesDistantBarricade(t *testing.T) {
+	gs := corridorState(world.CellBarricade, 1)
+	guard := &gs.Eepers[0]
+	guard.BreaksBarricades = true
+
+	gs.recomputePathForEeper(guard)
+	gs.clearEeperWay(guard)
+
+	if guard.BreakProgress != 0 {
+		t.Errorf("guard far from the barricade has break progress %d, want 0", guard.BreakProgress)
+	}
+	if guard.Position.X != nextToBlocker {
+		t.Errorf("guard at x=%d, want it next to the barricade at x=%d", guard.Position.X, nextToBlocker)
+	}
+}
+
+func TestClearEeperWayRestartsOnAnotherBarricade(t *testing.T) {
+	gs := corridorState(world.CellBarricade, nextToBlocker)
+	guard := &gs.Eepers[0]
+	guard.BreaksBarricades = true
+	guard.BreakTarget = world.IVector2{X: 9, Y: 9}
+	guard.BreakProgress = gs.Rules.BarricadeBreakTurns - 1
+
+	gs.recomputePathForEeper(guard)
+	gs.clearEeperWay(guard)
+
+	if guard.BreakProgress != 1 {
+		t.Errorf("break progress on a new barricade is %d, want 1", guard.BreakProgress)
+	}
+	if cell := gs.Map[2][6]; cell != world.CellBarricade {
+		t.Errorf("new barricade smashed with the progress of another one")
+	}
+}
+
+func TestClearEeperWayKeepsDoorWithoutKey(t *testing.T) {
+	gs := corridorState(world.CellDoorRed, nextToBlocker)
+	guard := &gs.Eepers[0]
+	guard.Keys[world.KeyCyan] = 1
+
+	gs.recomputePathForEeper(guard)
+	gs.clearEeperWay(guard)
+
+	if cell := gs.Map[2][6]; cell != world.CellDoorRed {
+		t.Errorf("red door cell is %v after clearEeperWay with a cyan key, want red door", cell)
+	}
+}
@@ -0,0 +1,297 @@
+package game
+
+import (
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+)
+
+// EeperTurn holds what an eeper knows during its turn. Handlers may update it,
+// e.g. OldPosition after a teleport so the eeper does not slide across the map.
+type EeperTurn struct {
+	OldPosition world.IVector2    // Position at the start of the turn, the eeper is drawn moving from here
+	OldEyes     entities.EyesKind // Eyes at the start of the turn
+	Distance    int               // Distance to the player on the eeper's distance map (-1 = unreachable)
+	SeesPlayer  bool              // Player was seen this turn
+}
+
+// BehaviourHandler acts out one turn of an eeper in a behaviour state.
+type BehaviourHandler func(gs *State, eeper *entities.EeperState, turn *EeperTurn)
+
+// BehaviourCondition decides whether a transition is taken this turn.
+type BehaviourCondition func(gs *State, eeper *entities.EeperState, turn *EeperTurn) bool
+
+// BehaviourTransition switches an eeper in one of the From states (any state
+// when empty) to the To state when its condition holds.
+type BehaviourTransition struct {
+	From []entities.EeperBehaviour
+	When BehaviourCondition
+	To   entities.EeperBehaviour
+}
+
+// appliesTo checks whether the transition starts from the given state
+func (t BehaviourTransition) appliesTo(behaviour entities.EeperBehaviour) bool {
+	if len(t.From) == 0 {
+		return true
+	}
+	for _, from := range t.From {
+		if from == behaviour {
+			return true
+		}
+	}
+	return false
+}
+
+// BehaviourProfile defines how an eeper kind acts. Each turn the eeper goes
+// through the stages in order:
+//
+//	Sense       - updates what the eeper knows (distance map); returning false skips the turn
+//	Interrupts  - momentary states acted out for this turn only, the eeper keeps its state
+//	Notice      - looks for the player (alert meter)
+//	Transitions - the first matching transition switches the state
+//	States      - the handler of the current state (Fallback for states without one)
+//	AfterTurn   - effects of the movement (regeneration, sliding, teleporting, ...)
+//
+// An interrupted turn skips everything after the interrupt. All stages but
+// States are optional.
+type BehaviourProfile struct {
+	Sense       func(gs *State, eeper *entities.EeperState, turn *EeperTurn) bool
+	Interrupts  []BehaviourTransition
+	Notice      BehaviourHandler
+	Transitions []BehaviourTransition
+	States      map[entities.EeperBehaviour]BehaviourHandler
+	Fallback    entities.EeperBehaviour
+	AfterTurn   BehaviourHandler
+}
+
+// behaviourProfiles holds the behaviour of every eeper kind. New kinds only
+// need a profile here to take part in UpdateEepers.
+var behaviourProfiles = map[entities.EeperKind]*BehaviourProfile{
+	entities.EeperGuard:  guardProfile,
+	entities.EeperMother: guardProfile, // Mothers behave exactly like guards, just larger
+	entities.EeperBoss:   bossProfile,
+	entities.EeperGnome:  gnomeProfile,
+	entities.EeperFather: fatherProfile,
+	entities.EeperBomber: bomberProfile,
+	entities.EeperMirror: mirrorProfile,
+}
+
+// runBehaviour plays one turn of the eeper at index i according to its profile
+func (gs *State) runBehaviour(i int, profile *BehaviourProfile) {
+	eeper := &gs.Eepers[i]
+	turn := EeperTurn{OldPosition: eeper.Position, OldEyes: eeper.Eyes}
+
+	if profile.Sense != nil && !profile.Sense(gs, eeper, &turn) {
+		return
+	}
+
+	interrupted := false
+	for _, interrupt := range profile.Interrupts {
+		if interrupt.appliesTo(eeper.Behaviour) && interrupt.When(gs, eeper, &turn) {
+			profile.States[interrupt.To](gs, eeper, &turn)
+			interrupted = true
+			break
+		}
+	}
+
+	if !interrupted {
+		if profile.Notice != nil {
+			profile.Notice(gs, eeper, &turn)
+		}
+
+		for _, transition := range profile.Transitions {
+			if transition.appliesTo(eeper.Behaviour) && transition.When(gs, eeper, &turn) {
+				eeper.Behaviour = transition.To
+				break
+			}
+		}
+
+		handler, found := profile.States[eeper.Behaviour]
+		if !found {
+			handler = profile.States[profile.Fallback]
+		}
+		handler(gs, eeper, &turn)
+
+		if profile.AfterTurn != nil {
+			profile.AfterTurn(gs, eeper, &turn)
+		}
+	}
+
+	// Handlers may spawn eepers and move gs.Eepers in memory
+	eeper = &gs.Eepers[i]
+
+	// Set previous position AFTER all movement and state changes
+	// This ensures interpolation works correctly
+	eeper.PrevPosition = turn.OldPosition
+	eeper.PrevEyes = turn.OldEyes
+}
+
+// Shared stages and conditions
+
+// senseDeath marks eepers without health left as dead and skips their turn
+func (gs *State) senseDeath(eeper *entities.EeperState, turn *EeperTurn) bool {
+	if eeper.Health <= 0 {
+		eeper.Dead = true
+		return false
+	}
+	return true
+}
+
+// noticePlayer updates the eeper's alert meter
+func (gs *State) noticePlayer(eeper *entities.EeperState, turn *EeperTurn) {
+	turn.SeesPlayer = gs.updateEeperAlert(eeper)
+}
+
+// onPlayer holds while the eeper's footprint covers the player
+func (gs *State) onPlayer(eeper *entities.EeperState, turn *EeperTurn) bool {
+	return turn.Distance == 0
+}
+
+// alertedAndReachable holds when a fully alert eeper sees a player it can get to
+func (gs *State) alertedAndReachable(eeper *entities.EeperState, turn *EeperTurn) bool {
+	return turn.SeesPlayer && eeper.Alert >= 1 && turn.Distance > 0
+}
+
+// alerted holds when a fully alert eeper sees the player
+func (gs *State) alerted(eeper *entities.EeperState, turn *EeperTurn) bool {
+	return turn.SeesPlayer && eeper.Alert >= 1
+}
+
+// lostPlayer holds when the eeper cannot see or reach the player anymore
+func (gs *State) lostPlayer(eeper *entities.EeperState, turn *EeperTurn) bool {
+	return !turn.SeesPlayer || turn.Distance < 0
+}
+
+// seesPlayer holds while the eeper sees the player
+func (gs *State) seesPlayer(eeper *entities.EeperState, turn *EeperTurn) bool {
+	return turn.SeesPlayer
+}
+
+// lostSightOfPlayer holds when the eeper cannot see the player anymore
+func (gs *State) lostSightOfPlayer(eeper *entities.EeperState, turn *EeperTurn) bool {
+	return !turn.SeesPlayer
+}
+
+// playerReachable holds while the player is on the eeper's distance map
+func (gs *State) playerReachable(eeper *entities.EeperState, turn *EeperTurn) bool {
+	return turn.Distance >= 0
+}
+
+// always holds every turn
+func (gs *State) always(eeper *entities.EeperState, turn *EeperTurn) bool {
+	return true
+}
+
+// Profiles
+
+// guardProfile: guards sleep or patrol until they notice the player, stare at
+// them while their alert meter fills up, chase them, search where they lost
+// them and attack whoever they stand on unless a status effect holds them back
+var guardProfile = &BehaviourProfile{
+	Sense: (*State).senseGuard,
+	Interrupts: []BehaviourTransition{
+		{When: (*State).stalled, To: entities.BehaviourStunned},
+		{When: (*State).onPlayer, To: entities.BehaviourAttack},
+	},
+	Notice: (*State).noticePlayer,
+	Transitions: []BehaviourTransition{
+		{When: (*State).alertedAndReachable, To: entities.BehaviourChase},
+		{From: []entities.EeperBehaviour{entities.BehaviourChase}, When: (*State).lostPlayer, To: entities.BehaviourInvestigate},
+		{From: []entities.EeperBehaviour{entities.BehaviourSleep, entities.BehaviourPatrol}, When: (*State).seesPlayer, To: entities.BehaviourAlert},
+		{From: []entities.EeperBehaviour{entities.BehaviourAlert}, When: (*State).lostSightOfPlayer, To: entities.BehaviourSleep},
+	},
+	States: map[entities.EeperBehaviour]BehaviourHandler{
+		entities.BehaviourStunned:     (*State).stallEeper,
+		entities.BehaviourAttack:      (*State).guardAttack,
+		entities.BehaviourAlert:       (*State).watchPlayer,
+		entities.BehaviourChase:       (*State).guardChase,
+		entities.BehaviourInvestigate: (*State).searchForPlayer,
+		entities.BehaviourSearch:      (*State).searchForPlayer,
+		entities.BehaviourReturn:      (*State).searchForPlayer,
+		entities.BehaviourSleep:       (*State).restEeper,
+		entities.BehaviourPatrol:      (*State).restEeper,
+	},
+	Fallback:  entities.BehaviourSleep,
+	AfterTurn: (*State).regenerateGuard,
+}
+
+// bossProfile: bosses fight like guards without regenerating and use the
+// abilities of their phase while chasing
+var bossProfile = &BehaviourProfile{
+	Sense:       (*State).senseGuard,
+	Interrupts:  guardProfile.Interrupts,
+	Notice:      (*State).noticePlayer,
+	Transitions: guardProfile.Transitions,
+	States: map[entities.EeperBehaviour]BehaviourHandler{
+		entities.BehaviourStunned:     (*State).stallEeper,
+		entities.BehaviourAttack:      (*State).bossAttack,
+		entities.BehaviourAlert:       (*State).watchPlayer,
+		entities.BehaviourChase:       (*State).guardChase,
+		entities.BehaviourInvestigate: (*State).searchForPlayer,
+		entities.BehaviourSearch:      (*State).searchForPlayer,
+		entities.BehaviourReturn:      (*State).searchForPlayer,
+		entities.BehaviourSleep:       (*State).restEeper,
+		entities.BehaviourPatrol:      (*State).restEeper,
+	},
+	Fallback:  entities.BehaviourSleep,
+	AfterTurn: (*State).bossAbilities,
+}
+
+// gnomeProfile: gnomes flee from a player that comes close, sneak towards
+// noises and sleep otherwise
+var gnomeProfile = &BehaviourProfile{
+	Sense: (*State).senseGnome,
+	Transitions: []BehaviourTransition{
+		{When: (*State).playerReachable, To: entities.BehaviourFlee},
+		{From: []entities.EeperBehaviour{entities.BehaviourFlee}, When: (*State).always, To: entities.BehaviourSleep},
+	},
+	States: map[entities.EeperBehaviour]BehaviourHandler{
+		entities.BehaviourFlee:        (*State).gnomeFlee,
+		entities.BehaviourInvestigate: (*State).gnomeInvestigate,
+		entities.BehaviourSleep:       (*State).gnomeSleep,
+	},
+	Fallback:  entities.BehaviourSleep,
+	AfterTurn: (*State).gnomeAfterMove,
+}
+
+// fatherProfile: the Father never moves, he only watches the player come close
+var fatherProfile = &BehaviourProfile{
+	States: map[entities.EeperBehaviour]BehaviourHandler{
+		entities.BehaviourSleep: (*State).fatherWatch,
+	},
+	Fallback: entities.BehaviourSleep,
+}
+
+// bomberProfile: bombers notice the player like guards, but keep their
+// distance and throw bombs while they see the player
+var bomberProfile = &BehaviourProfile{
+	Sense: (*State).senseBomber,
+	Interrupts: []BehaviourTransition{
+		{When: (*State).stalled, To: entities.BehaviourStunned},
+	},
+	Notice: (*State).noticePlayer,
+	Transitions: []BehaviourTransition{
+		{When: (*State).alerted, To: entities.BehaviourChase},
+		{From: []entities.EeperBehaviour{entities.BehaviourChase}, When: (*State).lostSightOfPlayer, To: entities.BehaviourInvestigate},
+		{From: []entities.EeperBehaviour{entities.BehaviourSleep, entities.BehaviourPatrol}, When: (*State).seesPlayer, To: entities.BehaviourAlert},
+		{From: []entities.EeperBehaviour{entities.BehaviourAlert}, When: (*State).lostSightOfPlayer, To: entities.BehaviourSleep},
+	},
+	States: map[entities.EeperBehaviour]BehaviourHandler{
+		entities.BehaviourStunned:     (*State).stallEeper,
+		entities.BehaviourAlert:       (*State).bomberWatch,
+		entities.BehaviourChase:       (*State).bomberAttack,
+		entities.BehaviourInvestigate: (*State).bomberSearch,
+		entities.BehaviourSearch:      (*State).bomberSearch,
+		entities.BehaviourReturn:      (*State).bomberSearch,
+		entities.BehaviourSleep:       (*State).bomberRest,
+		entities.BehaviourPatrol:      (*State).bomberRest,
+	},
+	Fallback: entities.BehaviourSleep,
+}
+
+// mirrorProfile: mirrors copy the player's moves every turn
+var mirrorProfile = &BehaviourProfile{
+	States: map[entities.EeperBehaviour]BehaviourHandler{
+		entities.BehaviourSleep: (*State).mirrorMove,
+	},
+	Fallback: entities.BehaviourSleep,
+}
@@ -0,0 +1,68 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+)
+
+// openRoomState builds an empty walled room with a sleeping guard at 4,1
+// looking down at the player at the given position
+func openRoomState(player world.IVector2) *State {
+	const width, height = 12, 9
+
+	gs := &State{Rules: DefaultRules()}
+	gs.Map = make([][]world.Cell, height)
+	for y := range gs.Map {
+		gs.Map[y] = make([]world.Cell, width)
+		for x := range gs.Map[y] {
+			if y == 0 || y == height-1 || x == 0 || x == width-1 {
+				gs.Map[y][x] = world.CellWall
+			} else {
+				gs.Map[y][x] = world.CellFloor
+			}
+		}
+	}
+
+	gs.Player.Position = player
+	gs.SpawnGuard(world.IVector2{X: 4, Y: 1})
+	return gs
+}
+
+func TestGuardStaresWhileAlertFillsUp(t *testing.T) {
+	gs := openRoomState(world.IVector2{X: 5, Y: 5})
+	guard := &gs.Eepers[0]
+
+	gs.UpdateEepers()
+
+	if guard.Behaviour != entities.BehaviourAlert {
+		t.Fatalf("guard behaviour is %v after seeing the player, want alert", guard.Behaviour)
+	}
+	if guard.Alert <= 0 || guard.Alert >= 1 {
+		t.Errorf("guard alert is %v after one turn, want partly filled", guard.Alert)
+	}
+	if guard.Position != (world.IVector2{X: 4, Y: 1}) {
+		t.Errorf("alert guard moved to %d,%d", guard.Position.X, guard.Position.Y)
+	}
+
+	for turn := 0; turn < 10 && guard.Behaviour == entities.BehaviourAlert; turn++ {
+		gs.UpdateEepers()
+	}
+	if guard.Behaviour != entities.BehaviourChase {
+		t.Errorf("guard behaviour is %v once its alert meter is full, want chase", guard.Behaviour)
+	}
+}
+
+func TestAlertGuardGoesBackToSleep(t *testing.T) {
+	gs := openRoomState(world.IVector2{X: 5, Y: 5})
+	guard := &gs.Eepers[0]
+
+	gs.UpdateEepers()
+	gs.Player.Position = world.IVector2{X: 10, Y: 1} // Out of the view cone
+	gs.UpdateEepers()
+
+	if guard.Behaviour != entities.BehaviourSleep {
+		t.Errorf("guard behaviour is %v after losing sight of the player, want sleep", guard.Behaviour)
+	}
+}
@@ -0,0 +1,63 @@
+package game
+
+import (
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+)
+
+// SpawnBlock places a pushable block at the given position.
+func (gs *State) SpawnBlock(position world.IVector2) {
+	gs.Map[position.Y][position.X] = world.CellBlock
+	gs.Blocks = append(gs.Blocks, entities.BlockState{
+		PrevPosition: position,
+		Position:     position,
+	})
+}
+
+// GetBlockAtPosition returns the block at the given position, or nil
+func (gs *State) GetBlockAtPosition(position world.IVector2) *entities.BlockState {
+	for i := range gs.Blocks {
+		if gs.Blocks[i].Position == position {
+			return &gs.Blocks[i]
+		}
+	}
+	return nil
+}
+
+// pushBlock shoves the block at position one cell in the direction if the cell
+// behind it is free floor. Returns whether the block moved.
+func (gs *State) pushBlock(position world.IVector2, dir world.IVector2) bool {
+	block := gs.GetBlockAtPosition(position)
+	if block == nil {
+		return false
+	}
+
+	target := position.Add(dir)
+	if !gs.WithinMap(target) || gs.Map[target.Y][target.X] != world.CellFloor || gs.isCellOccupied(target) {
+		return false
+	}
+	if gs.GetPortalAtPosition(target) != nil || gs.GetTeleporterAtPosition(target) != nil {
+		return false
+	}
+	for _, item := range gs.Items {
+		if item.Kind != entities.ItemNone && item.Position == target {
+			return false // Don't bury items under blocks
+		}
+	}
+
+	gs.Map[position.Y][position.X] = world.CellFloor
+	gs.Map[target.Y][target.X] = world.CellBlock
+	block.Position = target
+	return true
+}
+
+// destroyBlock removes the block at the given position, leaving floor behind
+func (gs *State) destroyBlock(position world.IVector2) {
+	for i := range gs.Blocks {
+		if gs.Blocks[i].Position == position {
+			gs.Blocks = append(gs.Blocks[:i], gs.Blocks[i+1:]...)
+			break
+		}
+	}
+	gs.Map[position.Y][position.X] = world.CellFloor
+}
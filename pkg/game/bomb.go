@@ -7,87 +7,223 @@ import (
 	rl "github.com/gen2brain/raylib-go/raylib"
 )
 
-const (
-	bombCountdown   = 3
-	explosionDamage = 0.45
-)
+// minBombCountdown leaves the player the two moves it takes to get out of a
+// blast: one away from the bomb and one round a corner
+const minBombCountdown = 2
 
-// PlantBomb creates a new bomb at the player's position.
+// PlantBomb creates a new bomb of the selected kind at the player's position.
 func (gs *State) PlantBomb() {
-	if gs.Player.Bombs > 0 {
+	kind := gs.Player.SelectedBomb
+	if kind == entities.BombNormal {
+		if gs.Player.Bombs <= 0 {
+			return
+		}
 		gs.Player.Bombs--
-		gs.Bombs = append(gs.Bombs, entities.BombState{
-			Position:  gs.Player.Position,
-			Countdown: bombCountdown,
-		})
-		rl.PlaySound(audio.PlantBombSound)
+	} else {
+		if gs.Player.SpecialBombs[kind] <= 0 {
+			return
+		}
+		gs.Player.SpecialBombs[kind]--
+		// Fall back to normal bombs once the last special bomb of the kind is planted
+		if gs.Player.SpecialBombs[kind] == 0 {
+			gs.Player.SelectedBomb = entities.BombNormal
+		}
+	}
+
+	countdown := gs.Rules.BombCountdown
+	if kind == entities.BombLongFuse {
+		countdown = gs.Rules.LongFuseCountdown
+	}
+	// Short fuse power-ups speed bombs up, but always leave the player the
+	// moves to step out of the blast
+	countdown -= gs.Player.Modifiers.FuseReduction
+	if countdown < minBombCountdown {
+		countdown = minBombCountdown
+	}
+	if kind == entities.BombRemote {
+		countdown = 0 // Never ticks, waits for DetonateRemoteBombs
+	}
+
+	gs.Bombs = append(gs.Bombs, entities.BombState{
+		Position:  gs.Player.Position,
+		Countdown: countdown,
+		Kind:      kind,
+		Range:     gs.Rules.ExplosionRadius + gs.Player.Modifiers.BlastRange,
+	})
+	rl.PlaySound(audio.PlantBombSound)
+}
+
+// CycleBombKind selects the next bomb kind the player has, normal bombs are always selectable
+func (gs *State) CycleBombKind() {
+	for i := 1; i < int(entities.BombKindCount); i++ {
+		kind := (gs.Player.SelectedBomb + entities.BombKind(i)) % entities.BombKindCount
+		if kind == entities.BombNormal || gs.Player.SpecialBombs[kind] > 0 {
+			gs.Player.SelectedBomb = kind
+			return
+		}
 	}
 }
 
 // UpdateBombs updates the state of all bombs.
 func (gs *State) UpdateBombs() {
 	// Reset damaged flag for all eepers at the start of the turn
-	for i := range gs.Eepers {
-		gs.Eepers[i].Damaged = false
-	}
+	gs.resetEeperDamage()
 
-	// Update bomb countdowns and explode if needed
-	for i := len(gs.Bombs) - 1; i >= 0; i-- {
-		bomb := &gs.Bombs[i]
-		bomb.Countdown--
+	// Update bomb countdowns, remote bombs wait for the detonator
+	for i := range gs.Bombs {
+		if gs.Bombs[i].Kind != entities.BombRemote {
+			gs.Bombs[i].Countdown--
+		}
+	}
 
-		if bomb.Countdown == 0 {
-			gs.Explode(bomb.Position)
-			gs.Bombs = append(gs.Bombs[:i], gs.Bombs[i+1:]...)
+	// Explode the bombs that ran out of time. Explosions may set off other
+	// bombs, so look for the next one after every detonation.
+	for {
+		i := gs.findBomb(func(bomb entities.BombState) bool {
+			return bomb.Kind != entities.BombRemote && bomb.Countdown <= 0
+		})
+		if i < 0 {
+			break
 		}
+		gs.DetonateBomb(i)
 	}
 
 	// Process damage to eepers after all explosions
+	gs.processEeperDamage()
+}
+
+// DetonateRemoteBombs sets off all planted remote bombs at once
+func (gs *State) DetonateRemoteBombs() {
+	gs.resetEeperDamage()
+
+	for {
+		i := gs.findBomb(func(bomb entities.BombState) bool {
+			return bomb.Kind == entities.BombRemote
+		})
+		if i < 0 {
+			break
+		}
+		gs.DetonateBomb(i)
+	}
+
+	gs.processEeperDamage()
+}
+
+// findBomb returns the index of the first planted bomb matching the predicate, or -1
+func (gs *State) findBomb(match func(entities.BombState) bool) int {
+	for i, bomb := range gs.Bombs {
+		if match(bomb) {
+			return i
+		}
+	}
+	return -1
+}
+
+// DetonateBomb removes the bomb at index i and explodes it in its kind's pattern
+func (gs *State) DetonateBomb(i int) {
+	bomb := gs.Bombs[i]
+	gs.Bombs = append(gs.Bombs[:i], gs.Bombs[i+1:]...)
+
+	radius := bomb.Range
+	if radius <= 0 {
+		radius = gs.Rules.ExplosionRadius
+	}
+
+	if bomb.Kind == entities.BombCross {
+		gs.explodeAlong(bomb.Position, Diagonals, radius, bomb.FromBoss)
+	} else {
+		gs.explodeAlong(bomb.Position, Directions, radius, bomb.FromBoss)
+	}
+}
+
+// resetEeperDamage clears the damaged flag of all eepers before explosions are processed
+func (gs *State) resetEeperDamage() {
+	for i := range gs.Eepers {
+		gs.Eepers[i].Damaged = false
+		gs.Eepers[i].Grazed = false
+	}
+}
+
+// processEeperDamage applies the damage of the explosions to the eepers caught in them
+func (gs *State) processEeperDamage() {
 	for i := range gs.Eepers {
 		eeper := &gs.Eepers[i]
-		if !eeper.Dead && eeper.Damaged {
+		if eeper.Dead || (!eeper.Damaged && !eeper.Grazed) {
+			continue
+		}
+
+		// Eepers caught only by the edge of a blast are stunned instead of hurt,
+		// gnomes and mirrors are too fragile to survive even that. A shielded
+		// boss shrugs it off like a full hit.
+		if !eeper.Damaged {
 			switch eeper.Kind {
-			case entities.EeperGuard:
-				eeper.Eyes = entities.EyesCringe
-				eeper.Health -= explosionDamage
-				if eeper.Health <= 0 {
-					eeper.Dead = true
+			case entities.EeperBoss:
+				if !gs.BossShielded(eeper) {
+					eeper.Eyes = entities.EyesDizzy
+					gs.applyEffect(eeper, entities.EffectStunned, gs.Rules.StunTurns)
 				}
-			case entities.EeperMother:
-				// Mother spawns 4 guards when killed
-				position := eeper.Position
-				eeper.Dead = true
-				gs.SpawnGuard(world.IVector2{X: position.X, Y: position.Y})
-				gs.SpawnGuard(world.IVector2{X: position.X + 4, Y: position.Y})
-				gs.SpawnGuard(world.IVector2{X: position.X, Y: position.Y + 4})
-				gs.SpawnGuard(world.IVector2{X: position.X + 4, Y: position.Y + 4})
-			case entities.EeperGnome:
-				// Gnome drops a key when killed
+				continue
+			case entities.EeperGuard, entities.EeperMother, entities.EeperBomber:
+				eeper.Eyes = entities.EyesDizzy
+				gs.applyEffect(eeper, entities.EffectStunned, gs.Rules.StunTurns)
+				continue
+			}
+		}
+
+		switch eeper.Kind {
+		case entities.EeperGuard, entities.EeperBomber:
+			eeper.Eyes = entities.EyesCringe
+			eeper.Health -= gs.Rules.ExplosionDamage
+			if eeper.Health <= 0 {
 				eeper.Dead = true
-				gs.AllocateItem(eeper.Position, entities.ItemKey)
-			case entities.EeperFather:
-				// Father is immune to explosions
+			} else {
+				// Wounded eepers go berserk for a while
+				gs.applyEffect(eeper, entities.EffectEnraged, gs.Rules.EnrageTurns)
 			}
+		case entities.EeperMother:
+			// Mother splits into her children when killed
+			eeper.Dead = true
+			gs.splitMother(*eeper)
+		case entities.EeperGnome:
+			// Gnome drops a key when killed
+			eeper.Dead = true
+			gs.AllocateKey(eeper.Position, eeper.KeyColor)
+			gs.dropLoot(eeper)
+		case entities.EeperMirror:
+			// Mirrors shatter in a single blast
+			eeper.Dead = true
+		case entities.EeperBoss:
+			gs.damageBoss(eeper)
+		case entities.EeperFather:
+			// Father is immune to explosions
 		}
 	}
 }
 
 // Explode creates an explosion at a given position.
 func (gs *State) Explode(position world.IVector2) {
+	gs.explodeAlong(position, Directions, gs.Rules.ExplosionRadius, false)
+}
+
+// explodeAlong creates an explosion at a given position with rays of the given
+// radius in the given directions. Rays that hit a planted bomb set it off immediately.
+// Explosions of bombs thrown by a boss spare bosses.
+func (gs *State) explodeAlong(position world.IVector2, directions [4]world.IVector2, radius int, fromBoss bool) {
 	// Create an explosion at the bomb's location
 	gs.Explosions = append(gs.Explosions, entities.ExplosionState{
 		Position:     position,
 		Timer:        20, // Explosion lasts for 20 frames
 		InitialTimer: 20,
 	})
-	gs.Map[position.Y][position.X] = world.CellExplosion
+	gs.coverWithExplosion(position)
 
 	// Damage eepers and player at explosion position
-	gs.damageAtPosition(position)
+	gs.damageAtPosition(position, false, fromBoss)
+	gs.chainBombAt(position)
 
 	// And in all four directions
-	for _, dir := range Directions {
-		for i := 1; i <= 4; i++ {
+	for _, dir := range directions {
+		for i := 1; i <= radius; i++ {
 			pos := position.Add(dir.Mul(i))
 			mapWidth := len(gs.Map[0])
 			mapHeight := len(gs.Map)
@@ -95,8 +231,31 @@ func (gs *State) Explode(position world.IVector2) {
 				break // Stop if we go out of bounds
 			}
 
-			if gs.Map[pos.Y][pos.X] == world.CellWall || gs.Map[pos.Y][pos.X] == world.CellDoor {
-				break // Stop if we hit a wall or a door
+			if gs.Map[pos.Y][pos.X] == world.CellWall || gs.Map[pos.Y][pos.X].IsDoor() || gs.Map[pos.Y][pos.X] == world.CellGateClosed {
+				break // Stop if we hit a wall, a door or a closed gate
+			}
+
+			// If we hit a boss weak point, destroy it and stop
+			if gs.Map[pos.Y][pos.X] == world.CellWeakPoint {
+				gs.Map[pos.Y][pos.X] = world.CellExplosion
+				gs.Explosions = append(gs.Explosions, entities.ExplosionState{
+					Position:     pos,
+					Timer:        20,
+					InitialTimer: 20,
+				})
+				break
+			}
+
+			// If we hit a block, blow it up and stop
+			if gs.Map[pos.Y][pos.X] == world.CellBlock {
+				gs.destroyBlock(pos)
+				gs.Explosions = append(gs.Explosions, entities.ExplosionState{
+					Position:     pos,
+					Timer:        20,
+					InitialTimer: 20,
+				})
+				gs.coverWithExplosion(pos)
+				break
 			}
 
 			// If we hit a barricade, flood fill it with explosions and stop
@@ -110,28 +269,58 @@ func (gs *State) Explode(position world.IVector2) {
 				Timer:        20,
 				InitialTimer: 20,
 			})
-			gs.Map[pos.Y][pos.X] = world.CellExplosion
+			gs.coverWithExplosion(pos)
 
-			// Damage eepers and player at this position
-			gs.damageAtPosition(pos)
+			// Damage eepers and player at this position, the edge of the blast only stuns eepers
+			gs.damageAtPosition(pos, i == radius, fromBoss)
+			gs.chainBombAt(pos)
 		}
 	}
 
 	rl.PlaySound(audio.BlastSound)
+	gs.EmitNoise(position, gs.Rules.BlastNoiseRadius)
 }
 
-// damageAtPosition damages player and eepers at the given position
-func (gs *State) damageAtPosition(pos world.IVector2) {
+// chainBombAt detonates a bomb planted at the position, if any
+func (gs *State) chainBombAt(pos world.IVector2) {
+	if i := gs.findBomb(func(bomb entities.BombState) bool { return bomb.Position == pos }); i >= 0 {
+		gs.DetonateBomb(i)
+	}
+}
+
+// coverWithExplosion marks a floor cell as exploding. Other cells (wiring,
+// terrain) keep their cell so they survive the blast, and pits are filled with
+// a bridge.
+func (gs *State) coverWithExplosion(pos world.IVector2) {
+	switch gs.Map[pos.Y][pos.X] {
+	case world.CellFloor:
+		gs.Map[pos.Y][pos.X] = world.CellExplosion
+	case world.CellPit:
+		gs.Map[pos.Y][pos.X] = world.CellBridge
+	}
+}
+
+// damageAtPosition damages player and eepers at the given position. Eepers
+// at the edge of the blast are only grazed, and bosses are spared by the
+// blasts of their own bombs.
+func (gs *State) damageAtPosition(pos world.IVector2, edge bool, fromBoss bool) {
 	// Damage player if at this position
 	if gs.Player.Position.X == pos.X && gs.Player.Position.Y == pos.Y {
-		gs.KillPlayer()
+		gs.DamagePlayer(DamageExplosion)
 	}
 
 	// Damage eepers that overlap with this position
 	for i := range gs.Eepers {
 		eeper := &gs.Eepers[i]
+		if fromBoss && eeper.Kind == entities.EeperBoss {
+			continue
+		}
 		if !eeper.Dead && gs.isInsideRect(eeper.Position, eeper.Size, pos) {
-			eeper.Damaged = true
+			if edge {
+				eeper.Grazed = true
+			} else {
+				eeper.Damaged = true
+			}
 		}
 	}
 }
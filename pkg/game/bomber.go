@@ -0,0 +1,164 @@
+package game
+
+import (
+	"github.com/engpetarmarinov/eepers-go/pkg/audio"
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/pathfinding"
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// SpawnBomber creates a new bomber eeper at the specified position
+func (gs *State) SpawnBomber(position world.IVector2) {
+	size := eeperSizes[entities.EeperBomber]
+
+	// Initialize path map with correct dimensions
+	height := len(gs.Map)
+	width := len(gs.Map[0])
+	path := make([][]int, height)
+	for i := range path {
+		path[i] = make([]int, width)
+		for j := range path[i] {
+			path[i][j] = -1
+		}
+	}
+
+	bomber := entities.EeperState{
+		Kind:           entities.EeperBomber,
+		Dead:           false,
+		Position:       position,
+		Post:           position,
+		PrevPosition:   position,
+		EyesAngle:      0,
+		EyesTarget:     world.IVector2{X: position.X + size.X/2, Y: position.Y + size.Y},
+		PrevEyes:       entities.EyesClosed,
+		Eyes:           entities.EyesClosed,
+		Size:           size,
+		Path:           path,
+		Damaged:        false,
+		Health:         1.0,
+		AttackCooldown: gs.Rules.BomberThrowCooldown,
+	}
+
+	gs.Eepers = append(gs.Eepers, bomber)
+}
+
+// senseBomber recomputes the bomber's distance map to the player
+func (gs *State) senseBomber(eeper *entities.EeperState, turn *EeperTurn) bool {
+	if !gs.senseDeath(eeper, turn) {
+		return false
+	}
+
+	// Bombers walk one cell at a time, so they use the walking distance map
+	gs.recomputePathForBomber(eeper)
+	turn.Distance = eeper.Path[eeper.Position.Y][eeper.Position.X]
+	return true
+}
+
+// bomberAttack keeps the bomber at a distance from the player it sees and
+// lobs bombs at them instead of charging
+func (gs *State) bomberAttack(eeper *entities.EeperState, turn *EeperTurn) {
+	// Back off while the player is too close
+	if turn.Distance >= 0 && turn.Distance < gs.Rules.BomberKeepDistance {
+		if gs.moveBomberAwayFromPlayer(eeper) {
+			rl.PlaySound(audio.GuardStepSound)
+		}
+	}
+
+	// Lob a bomb whenever the throw is ready (or the bomber is enraged) and the player is in sight
+	if eeper.AttackCooldown <= 0 || eeper.Effects[entities.EffectEnraged] > 0 {
+		if gs.bomberThrowBomb() {
+			eeper.AttackCooldown = gs.Rules.BomberThrowCooldown
+		}
+	} else {
+		eeper.AttackCooldown--
+	}
+
+	eeper.Eyes = entities.EyesAngry
+	eeper.EyesTarget = gs.Player.Position
+}
+
+// bomberSearch tracks down where the player was last seen, then heads back
+func (gs *State) bomberSearch(eeper *entities.EeperState, turn *EeperTurn) {
+	gs.searchForPlayer(eeper, turn)
+	eeper.AttackCooldown = gs.Rules.BomberThrowCooldown
+}
+
+// bomberRest walks the bomber's patrol route or lets it sleep
+func (gs *State) bomberRest(eeper *entities.EeperState, turn *EeperTurn) {
+	gs.restEeper(eeper, turn)
+	eeper.AttackCooldown = gs.Rules.BomberThrowCooldown
+}
+
+// bomberWatch makes a bomber that noticed the player stare at them with its bombs ready
+func (gs *State) bomberWatch(eeper *entities.EeperState, turn *EeperTurn) {
+	gs.watchPlayer(eeper, turn)
+	eeper.AttackCooldown = gs.Rules.BomberThrowCooldown
+}
+
+// recomputePathForBomber computes the walking distance map from the player for a bomber
+func (gs *State) recomputePathForBomber(eeper *entities.EeperState) {
+	canStand := func(p pathfinding.Point) bool {
+		return gs.eeperCanStandHere(world.IVector2{X: p.X, Y: p.Y}, eeper)
+	}
+
+	eeper.Path = pathfinding.ComputeDistanceMap(
+		gs.Map,
+		pathfinding.Point{X: gs.Player.Position.X, Y: gs.Player.Position.Y},
+		pathfinding.Point{X: eeper.Size.X, Y: eeper.Size.Y},
+		guardStepsLimit,
+		1, // Walks one cell per step
+		canStand,
+	)
+}
+
+// moveBomberAwayFromPlayer steps the bomber to a neighbouring position farther from the player
+func (gs *State) moveBomberAwayFromPlayer(eeper *entities.EeperState) bool {
+	currentDist := eeper.Path[eeper.Position.Y][eeper.Position.X]
+
+	// Find all adjacent positions with HIGHER distance (backing off)
+	var availablePositions []world.IVector2
+	for _, dir := range Directions {
+		newPos := eeper.Position.Add(dir)
+		if !gs.eeperCanStandHere(newPos, eeper) {
+			continue
+		}
+		if eeper.Path[newPos.Y][newPos.X] > currentDist {
+			availablePositions = append(availablePositions, newPos)
+		}
+	}
+
+	if len(availablePositions) == 0 {
+		return false
+	}
+	eeper.Position = availablePositions[rng.Intn(len(availablePositions))]
+	return true
+}
+
+// bomberThrowBomb lobs a bomb onto the player's cell or a free cell next to
+// it. Returns false if there is nowhere to land the bomb.
+func (gs *State) bomberThrowBomb() bool {
+	var targets []world.IVector2
+	for _, dir := range append([]world.IVector2{{}}, Directions[:]...) {
+		target := gs.Player.Position.Add(dir)
+		if !gs.WithinMap(target) || !gs.Map[target.Y][target.X].IsWalkable() {
+			continue
+		}
+		if gs.findBomb(func(bomb entities.BombState) bool { return bomb.Position == target }) >= 0 {
+			continue
+		}
+		targets = append(targets, target)
+	}
+
+	if len(targets) == 0 {
+		return false
+	}
+
+	gs.Bombs = append(gs.Bombs, entities.BombState{
+		Position:  targets[rng.Intn(len(targets))],
+		Countdown: gs.Rules.BombCountdown,
+		Kind:      entities.BombNormal,
+	})
+	rl.PlaySound(audio.PlantBombSound)
+	return true
+}
@@ -0,0 +1,172 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/audio"
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// SpawnBoss creates a new boss eeper at the specified position
+func (gs *State) SpawnBoss(position world.IVector2) {
+	size := eeperSizes[entities.EeperBoss]
+
+	// Initialize path map with correct dimensions
+	height := len(gs.Map)
+	width := len(gs.Map[0])
+	path := make([][]int, height)
+	for i := range path {
+		path[i] = make([]int, width)
+		for j := range path[i] {
+			path[i][j] = -1
+		}
+	}
+
+	boss := entities.EeperState{
+		Kind:           entities.EeperBoss,
+		Dead:           false,
+		Position:       position,
+		Post:           position,
+		PrevPosition:   position,
+		EyesAngle:      0,
+		EyesTarget:     world.IVector2{X: position.X + size.X/2, Y: position.Y + size.Y},
+		PrevEyes:       entities.EyesClosed,
+		Eyes:           entities.EyesClosed,
+		Size:           size,
+		Path:           path,
+		Damaged:        false,
+		Health:         1.0,
+		AttackCooldown: gs.Rules.GuardAttackCooldown,
+	}
+	phase := gs.BossPhase(&boss)
+	boss.SpawnCooldown = phase.GnomeInterval
+	boss.ThrowCooldown = phase.BombInterval
+
+	gs.Eepers = append(gs.Eepers, boss)
+}
+
+// checkBossWeakPoints checks that every weak point configured for the boss
+// phases is a weak point cell in the level
+func (gs *State) checkBossWeakPoints() error {
+	for i, phase := range gs.LevelConfig.Boss.Phases {
+		for _, weakPoint := range phase.WeakPoints {
+			if !gs.WithinMap(weakPoint) || gs.Map[weakPoint.Y][weakPoint.X] != world.CellWeakPoint {
+				return fmt.Errorf("level %s: no weak point at %d,%d for boss phase %d", gs.CurrentLevelPath, weakPoint.X, weakPoint.Y, i)
+			}
+		}
+	}
+
+	return nil
+}
+
+// BossPhase returns the config of the boss's current phase. Levels without
+// boss phases get a single phase without any abilities.
+func (gs *State) BossPhase(eeper *entities.EeperState) BossPhaseConfig {
+	phases := gs.LevelConfig.Boss.Phases
+	if eeper.BossPhase < 0 || eeper.BossPhase >= len(phases) {
+		return BossPhaseConfig{Health: 1.0}
+	}
+	return phases[eeper.BossPhase]
+}
+
+// BossShielded reports whether any weak point of the boss's current phase is still intact
+func (gs *State) BossShielded(eeper *entities.EeperState) bool {
+	for _, weakPoint := range gs.BossPhase(eeper).WeakPoints {
+		if gs.Map[weakPoint.Y][weakPoint.X] == world.CellWeakPoint {
+			return true
+		}
+	}
+	return false
+}
+
+// bossAttack hurts the player the boss is standing on, without holding back its abilities
+func (gs *State) bossAttack(eeper *entities.EeperState, turn *EeperTurn) {
+	gs.guardAttack(eeper, turn)
+	gs.bossAbilities(eeper, turn)
+}
+
+// bossAbilities uses the abilities of the boss's current phase while it is chasing the player
+func (gs *State) bossAbilities(eeper *entities.EeperState, turn *EeperTurn) {
+	if eeper.Behaviour != entities.BehaviourChase {
+		return
+	}
+
+	phase := gs.BossPhase(eeper)
+
+	if phase.BombInterval > 0 {
+		eeper.ThrowCooldown--
+		if eeper.ThrowCooldown <= 0 {
+			eeper.ThrowCooldown = phase.BombInterval
+			gs.bossThrowBomb()
+		}
+	}
+
+	// Spawning last, since appending to gs.Eepers may move the boss in memory
+	if phase.GnomeInterval > 0 {
+		eeper.SpawnCooldown--
+		if eeper.SpawnCooldown <= 0 {
+			eeper.SpawnCooldown = phase.GnomeInterval
+			gs.bossSpawnGnome(eeper.Position, eeper.Size)
+		}
+	}
+}
+
+// bossThrowBomb lands a bomb on the player's position
+func (gs *State) bossThrowBomb() {
+	target := gs.Player.Position
+	if gs.findBomb(func(bomb entities.BombState) bool { return bomb.Position == target }) >= 0 {
+		return
+	}
+
+	gs.Bombs = append(gs.Bombs, entities.BombState{
+		Position:  target,
+		Countdown: gs.Rules.BombCountdown,
+		Kind:      entities.BombNormal,
+		FromBoss:  true,
+	})
+	rl.PlaySound(audio.PlantBombSound)
+}
+
+// bossSpawnGnome spawns a gnome on a random free cell right next to the boss's footprint
+func (gs *State) bossSpawnGnome(position world.IVector2, size world.IVector2) {
+	var candidates []world.IVector2
+	for y := position.Y - 1; y <= position.Y+size.Y; y++ {
+		for x := position.X - 1; x <= position.X+size.X; x++ {
+			cell := world.IVector2{X: x, Y: y}
+			if gs.isInsideRect(position, size, cell) || !gs.WithinMap(cell) {
+				continue
+			}
+			if gs.Map[y][x].IsWalkable() && !gs.isCellOccupied(cell) {
+				candidates = append(candidates, cell)
+			}
+		}
+	}
+
+	if len(candidates) > 0 {
+		gs.SpawnGnome(candidates[rng.Intn(len(candidates))])
+	}
+}
+
+// damageBoss hurts a boss caught in an explosion unless its shield is up,
+// advancing it to the next phase once its health drops far enough
+func (gs *State) damageBoss(eeper *entities.EeperState) {
+	if gs.BossShielded(eeper) {
+		return // Weak points of this phase are still intact
+	}
+
+	eeper.Eyes = entities.EyesCringe
+	eeper.Health -= gs.Rules.BossExplosionDamage
+	if eeper.Health <= 0 {
+		eeper.Dead = true
+		return
+	}
+
+	phases := gs.LevelConfig.Boss.Phases
+	for eeper.BossPhase+1 < len(phases) && eeper.Health <= phases[eeper.BossPhase+1].Health {
+		eeper.BossPhase++
+		eeper.SpawnCooldown = phases[eeper.BossPhase].GnomeInterval
+		eeper.ThrowCooldown = phases[eeper.BossPhase].BombInterval
+	}
+}
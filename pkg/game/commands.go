@@ -0,0 +1,263 @@
+package game
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/console"
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+)
+
+// RegisterCommands registers the gameplay commands operating on this state
+func (gs *State) RegisterCommands(c *console.Console) {
+	c.Register(console.Command{
+		Name:        "tp",
+		Usage:       "<x> <y>",
+		Description: "Teleport the player to a cell",
+		Run:         gs.cmdTeleport,
+	})
+	c.Register(console.Command{
+		Name:        "give",
+		Usage:       "<keys|bombs|slots> [amount] [key colour]",
+		Description: "Give the player keys (cyan by default), bombs or bomb slots",
+		Run:         gs.cmdGive,
+	})
+	c.Register(console.Command{
+		Name:        "spawn",
+		Usage:       "<guard|mother|gnome|father> [x y]",
+		Description: "Spawn an eeper (at the player by default)",
+		Run:         gs.cmdSpawn,
+	})
+	c.Register(console.Command{
+		Name:        "kill",
+		Usage:       "<all|index|kind>",
+		Description: "Kill eepers by index, kind or all of them",
+		Run:         gs.cmdKill,
+	})
+	c.Register(console.Command{
+		Name:        "god",
+		Description: "Toggle god mode (player cannot die)",
+		Run: func(args []string) (string, error) {
+			gs.GodMode = !gs.GodMode
+			return fmt.Sprintf("God mode: %t", gs.GodMode), nil
+		},
+	})
+	c.Register(console.Command{
+		Name:        "level",
+		Usage:       "<portal|hub>",
+		Description: "Load the level behind a portal of the current world",
+		Run:         gs.cmdLevel,
+	})
+	c.Register(console.Command{
+		Name:        "reload",
+		Description: "Reload the current level",
+		Run: func(args []string) (string, error) {
+			if err := gs.LoadLevel(gs.CurrentLevelPath, gs.InHub); err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("Reloaded %s", gs.CurrentLevelPath), nil
+		},
+	})
+	c.Register(console.Command{
+		Name:        "dump",
+		Usage:       "[path] [nopaths]",
+		Description: "Dump the game state to a JSON snapshot file",
+		Run:         gs.cmdDump,
+	})
+	c.Register(console.Command{
+		Name:        "load",
+		Usage:       "[path]",
+		Description: "Load the game state from a JSON snapshot file",
+		Run: func(args []string) (string, error) {
+			path := DefaultSnapshotPath
+			if len(args) > 0 {
+				path = args[0]
+			}
+			if err := gs.LoadSnapshot(path); err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("Loaded snapshot %s", path), nil
+		},
+	})
+	c.Register(console.Command{
+		Name:        "seed",
+		Usage:       "<seed>",
+		Description: "Seed the gameplay random number generator",
+		Run: func(args []string) (string, error) {
+			if len(args) != 1 {
+				return "", fmt.Errorf("expected a seed")
+			}
+			seed, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return "", fmt.Errorf("invalid seed %q", args[0])
+			}
+			SeedRandom(seed)
+			return fmt.Sprintf("Seeded RNG with %d", seed), nil
+		},
+	})
+}
+
+// parseCell parses x and y console arguments into a position inside the map
+func (gs *State) parseCell(xArg, yArg string) (world.IVector2, error) {
+	x, errX := strconv.Atoi(xArg)
+	y, errY := strconv.Atoi(yArg)
+	if errX != nil || errY != nil {
+		return world.IVector2{}, fmt.Errorf("invalid position %s,%s", xArg, yArg)
+	}
+	pos := world.IVector2{X: x, Y: y}
+	if !gs.WithinMap(pos) {
+		return world.IVector2{}, fmt.Errorf("position %d,%d is outside the map", x, y)
+	}
+	return pos, nil
+}
+
+func (gs *State) cmdTeleport(args []string) (string, error) {
+	if len(args) != 2 {
+		return "", fmt.Errorf("expected x and y")
+	}
+	pos, err := gs.parseCell(args[0], args[1])
+	if err != nil {
+		return "", err
+	}
+
+	gs.Player.Position = pos
+	gs.Player.PrevPosition = pos
+	gs.Player.EyesTarget = world.IVector2{X: pos.X, Y: pos.Y + 1}
+	return fmt.Sprintf("Teleported to %d,%d", pos.X, pos.Y), nil
+}
+
+func (gs *State) cmdGive(args []string) (string, error) {
+	if len(args) < 1 {
+		return "", fmt.Errorf("expected what to give")
+	}
+
+	amount := 1
+	if len(args) > 1 {
+		var err error
+		amount, err = strconv.Atoi(args[1])
+		if err != nil {
+			return "", fmt.Errorf("invalid amount %q", args[1])
+		}
+	}
+
+	switch strings.ToLower(args[0]) {
+	case "keys", "key":
+		color := world.KeyCyan
+		if len(args) > 2 {
+			var err error
+			color, err = world.ParseKeyColor(args[2])
+			if err != nil {
+				return "", err
+			}
+		}
+		gs.Player.Keys[color] += amount
+	case "bombs", "bomb":
+		gs.Player.Bombs += amount
+		// Make room for the bombs so they are not lost on the next pickup check
+		if gs.Player.Bombs > gs.Player.BombSlots {
+			gs.Player.BombSlots = gs.Player.Bombs
+		}
+	case "slots", "slot":
+		gs.Player.BombSlots += amount
+	default:
+		return "", fmt.Errorf("unknown item %q", args[0])
+	}
+
+	var keys []string
+	for color, count := range gs.Player.Keys {
+		keys = append(keys, fmt.Sprintf("%s %d", world.KeyColor(color), count))
+	}
+	return fmt.Sprintf("Keys: %s  Bombs: %d/%d", strings.Join(keys, ", "), gs.Player.Bombs, gs.Player.BombSlots), nil
+}
+
+func (gs *State) cmdSpawn(args []string) (string, error) {
+	if len(args) != 1 && len(args) != 3 {
+		return "", fmt.Errorf("expected a kind and optionally x and y")
+	}
+
+	kind, err := entities.ParseEeperKind(args[0])
+	if err != nil {
+		return "", err
+	}
+
+	pos := gs.Player.Position
+	if len(args) == 3 {
+		pos, err = gs.parseCell(args[1], args[2])
+		if err != nil {
+			return "", err
+		}
+	}
+
+	gs.SpawnEeper(kind, pos)
+
+	return fmt.Sprintf("Spawned %s #%d at %d,%d", kind, len(gs.Eepers)-1, pos.X, pos.Y), nil
+}
+
+func (gs *State) cmdKill(args []string) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("expected all, an index or a kind")
+	}
+
+	killed := 0
+	arg := strings.ToLower(args[0])
+	if index, err := strconv.Atoi(arg); err == nil {
+		if index < 0 || index >= len(gs.Eepers) {
+			return "", fmt.Errorf("no eeper with index %d", index)
+		}
+		if !gs.Eepers[index].Dead {
+			gs.Eepers[index].Dead = true
+			killed++
+		}
+	} else {
+		kind, err := entities.ParseEeperKind(arg)
+		if arg != "all" && err != nil {
+			return "", fmt.Errorf("unknown eeper kind %q", args[0])
+		}
+		for i := range gs.Eepers {
+			eeper := &gs.Eepers[i]
+			if !eeper.Dead && (arg == "all" || eeper.Kind == kind) {
+				eeper.Dead = true
+				killed++
+			}
+		}
+	}
+
+	return fmt.Sprintf("Killed %d eeper(s)", killed), nil
+}
+
+func (gs *State) cmdLevel(args []string) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("expected a portal number or hub")
+	}
+
+	if strings.ToLower(args[0]) == "hub" {
+		if err := gs.LoadHub(); err != nil {
+			return "", err
+		}
+		return "Loaded hub", nil
+	}
+
+	portal, err := strconv.Atoi(args[0])
+	if err != nil || !gs.WorldConfig.HasLevel(portal) {
+		return "", fmt.Errorf("no level behind portal %q", args[0])
+	}
+	if err := gs.LoadLevelFromPortal(portal); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Loaded %s", gs.CurrentLevelPath), nil
+}
+
+func (gs *State) cmdDump(args []string) (string, error) {
+	path := DefaultSnapshotPath
+	if len(args) > 0 {
+		path = args[0]
+	}
+	includePaths := len(args) < 2 || strings.ToLower(args[1]) != "nopaths"
+
+	if err := gs.SaveSnapshot(path, includePaths); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Dumped state to %s", path), nil
+}
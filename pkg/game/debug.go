@@ -0,0 +1,46 @@
+package game
+
+import "github.com/engpetarmarinov/eepers-go/pkg/entities"
+
+// DebugState holds the state of the developer debug overlay.
+type DebugState struct {
+	Enabled       bool // Whether the overlay is drawn
+	SelectedEeper int  // Index into State.Eepers of the inspected eeper
+}
+
+// ToggleDebugOverlay turns the debug overlay on or off
+func (gs *State) ToggleDebugOverlay() {
+	gs.Debug.Enabled = !gs.Debug.Enabled
+	if gs.Debug.Enabled && gs.SelectedDebugEeper() == nil {
+		gs.SelectNextDebugEeper()
+	}
+}
+
+// SelectNextDebugEeper cycles the inspected eeper to the next living one
+func (gs *State) SelectNextDebugEeper() {
+	if len(gs.Eepers) == 0 {
+		gs.Debug.SelectedEeper = 0
+		return
+	}
+
+	for i := 1; i <= len(gs.Eepers); i++ {
+		index := (gs.Debug.SelectedEeper + i) % len(gs.Eepers)
+		if !gs.Eepers[index].Dead {
+			gs.Debug.SelectedEeper = index
+			return
+		}
+	}
+}
+
+// SelectedDebugEeper returns the eeper currently inspected by the debug overlay,
+// or nil if there is none (e.g. all eepers are dead or the level was reloaded)
+func (gs *State) SelectedDebugEeper() *entities.EeperState {
+	if gs.Debug.SelectedEeper < 0 || gs.Debug.SelectedEeper >= len(gs.Eepers) {
+		return nil
+	}
+	eeper := &gs.Eepers[gs.Debug.SelectedEeper]
+	if eeper.Dead {
+		return nil
+	}
+	return eeper
+}
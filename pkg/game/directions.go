@@ -9,3 +9,11 @@ var Directions = [4]world.IVector2{
 	{X: -1, Y: 0}, // Left
 	{X: 1, Y: 0},  // Right
 }
+
+// Diagonals defines the four diagonal directions used by cross bombs.
+var Diagonals = [4]world.IVector2{
+	{X: -1, Y: -1}, // Up left
+	{X: 1, Y: -1},  // Up right
+	{X: -1, Y: 1},  // Down left
+	{X: 1, Y: 1},   // Down right
+}
@@ -1,8 +1,6 @@
 package game
 
 import (
-	"math/rand"
-
 	"github.com/engpetarmarinov/eepers-go/pkg/audio"
 	"github.com/engpetarmarinov/eepers-go/pkg/entities"
 	"github.com/engpetarmarinov/eepers-go/pkg/pathfinding"
@@ -11,125 +9,146 @@ import (
 )
 
 const (
-	guardAttackCooldown   = 10
-	guardStepsLimit       = 100 // How many pathfinding steps to search
-	guardStepLengthLimit  = 100 // How far to look in each direction during pathfinding
-	guardTurnRegeneration = 0.01
-	gnomeStepsLimit       = 9 // Gnomes can detect player up to 9 steps away
-	gnomeStepLengthLimit  = 1 // Gnomes move only 1 cell at a time
-	fatherWakeUpRadius    = 3 // Father wakes up when player is within 3 cells
+	guardStepsLimit      = 100 // How many pathfinding steps to search
+	guardStepLengthLimit = 100 // How far to look in each direction during pathfinding
+	gnomeFleeLookahead   = 4   // How many steps ahead a fleeing gnome looks for a safe cell
+	gnomeDeadEndPenalty  = 8   // Score taken from cells with at most one way out
 )
 
-// UpdateEepers updates the state of all eepers.
+// eeperSizes holds the footprint of each eeper kind, used by the spawn functions
+var eeperSizes = map[entities.EeperKind]world.IVector2{
+	entities.EeperGuard:  {X: 3, Y: 3},
+	entities.EeperMother: {X: 7, Y: 7}, // Mothers are large
+	entities.EeperGnome:  {X: 1, Y: 1},
+	entities.EeperFather: {X: 7, Y: 7}, // Same as Mother
+	entities.EeperBoss:   {X: 7, Y: 7}, // As large as Mothers
+	entities.EeperBomber: {X: 2, Y: 2}, // Between gnomes and guards
+	entities.EeperMirror: {X: 1, Y: 1}, // As small as the player they copy
+}
+
+// UpdateEepers updates the state of all eepers. Every kind acts according to
+// its behaviour profile, see behaviourProfiles.
 func (gs *State) UpdateEepers() {
 	for i := range gs.Eepers {
 		eeper := &gs.Eepers[i]
 		if eeper.Dead {
 			continue
 		}
+		eeper.Spawning = false
 
-		switch eeper.Kind {
-		case entities.EeperGuard:
-			gs.updateGuard(eeper)
-		case entities.EeperMother:
-			gs.updateMother(eeper)
-		case entities.EeperGnome:
-			gs.updateGnome(eeper)
-		case entities.EeperFather:
-			gs.updateFather(eeper)
+		if profile, ok := behaviourProfiles[eeper.Kind]; ok {
+			gs.runBehaviour(i, profile)
 		}
+		gs.tickEffects(&gs.Eepers[i])
 	}
 }
 
-func (gs *State) updateGuard(eeper *entities.EeperState) {
-	if eeper.Health <= 0 {
-		eeper.Dead = true
-		return
+// senseGuard recomputes the guard's distance map to the player
+func (gs *State) senseGuard(eeper *entities.EeperState, turn *EeperTurn) bool {
+	if !gs.senseDeath(eeper, turn) {
+		return false
 	}
 
-	// Store the current position before any movement
-	oldPosition := eeper.Position
-	oldEyes := eeper.Eyes
+	// Smashing a barricade starts over once the guard stopped chasing
+	if eeper.Behaviour != entities.BehaviourChase {
+		eeper.BreakProgress = 0
+	}
 
 	// Recompute the distance map for this guard
 	gs.recomputePathForEeper(eeper)
 
 	// Check the distance to player at guard's current position
-	currentDist := eeper.Path[eeper.Position.Y][eeper.Position.X]
+	turn.Distance = eeper.Path[eeper.Position.Y][eeper.Position.X]
+	return true
+}
 
-	// If guard is at player position (distance 0), kill player
-	if currentDist == 0 {
-		gs.KillPlayer()
-		eeper.Eyes = entities.EyesSurprised
-		eeper.PrevPosition = oldPosition
-		eeper.PrevEyes = oldEyes
-		return
-	}
+// guardAttack hurts the player the guard is standing on
+func (gs *State) guardAttack(eeper *entities.EeperState, turn *EeperTurn) {
+	gs.DamagePlayer(DamageGuard)
+	eeper.Eyes = entities.EyesSurprised
+}
 
-	// If player is reachable (distance > 0)
-	if currentDist > 0 {
-		// Guard is awake and tracking player
-		if eeper.AttackCooldown <= 0 {
-			// Try to move closer to player
-			moved := gs.moveGuardTowardPlayer(eeper)
-			if moved {
-				rl.PlaySound(audio.GuardStepSound)
+// guardChase makes an awake guard jump towards the player it is tracking
+func (gs *State) guardChase(eeper *entities.EeperState, turn *EeperTurn) {
+	// Enraged guards do not wait for their cooldown
+	if eeper.AttackCooldown <= 0 || eeper.Effects[entities.EffectEnraged] > 0 {
+		// Try to move closer to player
+		if gs.moveGuardTowardPlayer(eeper) {
+			rl.PlaySound(audio.GuardStepSound)
+			eeper.BreakProgress = 0
+			// Follow the player through a teleporter pad, appearing instantly on the other side
+			if gs.teleportEeper(eeper) {
+				turn.OldPosition = eeper.Position
 			}
-			eeper.AttackCooldown = guardAttackCooldown
 		} else {
-			// Decrement cooldown while waiting
-			eeper.AttackCooldown--
+			// Open the door or smash the barricade blocking the way
+			gs.clearEeperWay(eeper)
 		}
+		eeper.AttackCooldown = gs.Rules.GuardAttackCooldown
+	} else {
+		// Decrement cooldown while waiting
+		eeper.AttackCooldown--
+	}
 
-		// Set eye state based on distance
-		if eeper.Path[eeper.Position.Y][eeper.Position.X] == 1 {
-			eeper.Eyes = entities.EyesAngry
-		} else {
-			eeper.Eyes = entities.EyesOpen
+	// Set eye state based on distance
+	if eeper.Path[eeper.Position.Y][eeper.Position.X] == 1 {
+		eeper.Eyes = entities.EyesAngry
+	} else {
+		eeper.Eyes = entities.EyesOpen
+	}
+	eeper.EyesTarget = gs.Player.Position
+
+	// Check if guard caught player by moving onto them
+	if gs.isPlayerInAttackRange(eeper) {
+		gs.DamagePlayer(DamageGuard)
+	}
+}
+
+// regenerateGuard heals a wounded guard a little every turn
+func (gs *State) regenerateGuard(eeper *entities.EeperState, turn *EeperTurn) {
+	if eeper.Health < 1.0 {
+		eeper.Health += gs.Rules.GuardTurnRegeneration
+		if eeper.Health > 1.0 {
+			eeper.Health = 1.0
 		}
-		eeper.EyesTarget = gs.Player.Position
+	}
+}
 
-		// Check if guard caught player by moving onto them
-		if gs.isPlayerInAttackRange(eeper) {
-			gs.KillPlayer()
+// restEeper makes an eeper that is not after the player walk its patrol route
+// or sleep, opening its eyes at the player while it is becoming alert
+func (gs *State) restEeper(eeper *entities.EeperState, turn *EeperTurn) {
+	if len(eeper.Patrol) > 0 {
+		// Guard walks its patrol route
+		eeper.Behaviour = entities.BehaviourPatrol
+		if gs.patrolStep(eeper) {
+			rl.PlaySound(audio.GuardStepSound)
+			// Look where we're walking
+			eeper.EyesTarget = eeper.Position.Add(eeper.Position.Sub(turn.OldPosition).Mul(eeper.Size.X))
 		}
+		eeper.Eyes = entities.EyesOpen
 	} else {
-		// Player is not reachable - guard is sleeping
+		// Guard is sleeping
+		eeper.Behaviour = entities.BehaviourSleep
 		eeper.Eyes = entities.EyesClosed
 		eeper.EyesTarget = world.IVector2{
 			X: eeper.Position.X + eeper.Size.X/2,
 			Y: eeper.Position.Y + eeper.Size.Y,
 		}
-		eeper.AttackCooldown = guardAttackCooldown + 1
-	}
-
-	// Health regeneration
-	if eeper.Health < 1.0 {
-		eeper.Health += guardTurnRegeneration
-		if eeper.Health > 1.0 {
-			eeper.Health = 1.0
-		}
 	}
 
-	// Set previous position AFTER all movement and state changes
-	// This ensures interpolation works correctly
-	eeper.PrevPosition = oldPosition
-	eeper.PrevEyes = oldEyes
+	eeper.AttackCooldown = gs.Rules.GuardAttackCooldown + 1
 }
 
-// updateMother updates a Mother eeper - behaves exactly like guards but larger
-func (gs *State) updateMother(eeper *entities.EeperState) {
-	// Mother eepers behave identically to guards, just with different size
-	// Reuse the guard update logic
-	gs.updateGuard(eeper)
+// watchPlayer makes an eeper that noticed the player stand still and stare at
+// them while its alert meter fills up
+func (gs *State) watchPlayer(eeper *entities.EeperState, turn *EeperTurn) {
+	eeper.Eyes = entities.EyesSurprised
+	eeper.EyesTarget = gs.Player.Position
+	eeper.AttackCooldown = gs.Rules.GuardAttackCooldown + 1
 }
 
-// updateFather updates the Father eeper - the goal of the game!
-func (gs *State) updateFather(eeper *entities.EeperState) {
-	// Set previous position at START of turn
-	eeper.PrevPosition = eeper.Position
-	eeper.PrevEyes = eeper.Eyes
-
+// fatherWatch updates the Father eeper - the goal of the game!
+func (gs *State) fatherWatch(eeper *entities.EeperState, turn *EeperTurn) {
 	// Check if player is touching Father (victory condition!)
 	if gs.isPlayerInAttackRange(eeper) {
 		// Player reached Father - trigger victory!
@@ -141,14 +160,15 @@ func (gs *State) updateFather(eeper *entities.EeperState) {
 		return
 	}
 
-	// Check if player is within wake-up radius (3 cells around Father)
+	// Check if player is within wake-up radius (cells around Father)
+	wakeUpRadius := gs.Rules.FatherWakeUpRadius
 	wakeUpRect := struct {
 		X, Y, W, H int
 	}{
-		X: eeper.Position.X - fatherWakeUpRadius,
-		Y: eeper.Position.Y - fatherWakeUpRadius,
-		W: eeper.Size.X + fatherWakeUpRadius*2,
-		H: eeper.Size.Y + fatherWakeUpRadius*2,
+		X: eeper.Position.X - wakeUpRadius,
+		Y: eeper.Position.Y - wakeUpRadius,
+		W: eeper.Size.X + wakeUpRadius*2,
+		H: eeper.Size.Y + wakeUpRadius*2,
 	}
 
 	playerInWakeRadius := gs.Player.Position.X >= wakeUpRect.X &&
@@ -171,9 +191,28 @@ func (gs *State) updateFather(eeper *entities.EeperState) {
 }
 
 func (gs *State) moveGuardTowardPlayer(eeper *entities.EeperState) bool {
+	availablePositions := gs.GuardMoveCandidates(eeper)
+
+	// If we found valid moves, pick one randomly
+	if len(availablePositions) > 0 {
+		newPos := availablePositions[rng.Intn(len(availablePositions))]
+		eeper.Position = newPos
+		return true
+	}
+
+	return false
+}
+
+// GuardMoveCandidates returns all positions the eeper could jump to that are
+// one step closer to the player according to its current distance map.
+func (gs *State) GuardMoveCandidates(eeper *entities.EeperState) []world.IVector2 {
+	if eeper.Path == nil {
+		return nil
+	}
+
 	currentDist := eeper.Path[eeper.Position.Y][eeper.Position.X]
 	if currentDist <= 0 {
-		return false
+		return nil
 	}
 
 	// Find all positions that are one step closer to the player
@@ -221,34 +260,46 @@ func (gs *State) moveGuardTowardPlayer(eeper *entities.EeperState) bool {
 		}
 	}
 
-	// If we found valid moves, pick one randomly
-	if len(availablePositions) > 0 {
-		newPos := availablePositions[rand.Intn(len(availablePositions))]
-		eeper.Position = newPos
-		return true
-	}
-
-	return false
+	return availablePositions
 }
 
 func (gs *State) recomputePathForEeper(eeper *entities.EeperState) {
 	// Create a function to check if the eeper can stand at a position
+	// Doors and barricades the eeper can get through are part of its way to the player
 	canStand := func(p pathfinding.Point) bool {
 		pos := world.IVector2{X: p.X, Y: p.Y}
-		return gs.eeperCanStandHere(pos, eeper)
+		return gs.eeperFits(pos, eeper, func(cell world.Cell) bool {
+			return cell.IsWalkable() || cell == world.CellExplosion || gs.eeperCanClear(eeper, cell)
+		})
+	}
+
+	// Guards may follow the player through teleporter pads
+	var links map[pathfinding.Point]pathfinding.Point
+	if gs.Rules.GuardsUseTeleporters {
+		links = gs.teleporterLinks()
 	}
 
-	eeper.Path = pathfinding.ComputeDistanceMap(
+	eeper.Path = pathfinding.ComputeDistanceMapWithLinks(
 		gs.Map,
 		pathfinding.Point{X: gs.Player.Position.X, Y: gs.Player.Position.Y},
 		pathfinding.Point{X: eeper.Size.X, Y: eeper.Size.Y},
 		guardStepsLimit,
 		guardStepLengthLimit,
 		canStand,
+		links,
 	)
 }
 
 func (gs *State) eeperCanStandHere(pos world.IVector2, currentEeper *entities.EeperState) bool {
+	// Check if cell is floor-like or explosion (can step into explosions)
+	return gs.eeperFits(pos, currentEeper, func(cell world.Cell) bool {
+		return cell.IsWalkable() || cell == world.CellExplosion
+	})
+}
+
+// eeperFits checks that every cell of the eeper's footprint at pos is in the
+// map, enterable according to canEnter and not taken by another eeper
+func (gs *State) eeperFits(pos world.IVector2, currentEeper *entities.EeperState, canEnter func(world.Cell) bool) bool {
 	// Check ALL cells that the eeper occupies (e.g., 3x3 for guards)
 	// This is critical - guards can't move through walls!
 	for x := pos.X; x < pos.X+currentEeper.Size.X; x++ {
@@ -260,9 +311,7 @@ func (gs *State) eeperCanStandHere(pos world.IVector2, currentEeper *entities.Ee
 				return false
 			}
 
-			// Check if cell is floor or explosion (can step into explosions)
-			cell := gs.Map[cellPos.Y][cellPos.X]
-			if cell != world.CellFloor && cell != world.CellExplosion {
+			if !canEnter(gs.Map[cellPos.Y][cellPos.X]) {
 				return false
 			}
 
@@ -283,111 +332,221 @@ func (gs *State) eeperCanStandHere(pos world.IVector2, currentEeper *entities.Ee
 	return true
 }
 
+// eeperAt returns the eeper of one of the given kinds spawned at pos, the
+// position level configs use to refer to eepers, or nil if there is none
+func (gs *State) eeperAt(pos world.IVector2, kinds ...entities.EeperKind) *entities.EeperState {
+	for i := range gs.Eepers {
+		eeper := &gs.Eepers[i]
+		if eeper.Position != pos {
+			continue
+		}
+		for _, kind := range kinds {
+			if eeper.Kind == kind {
+				return eeper
+			}
+		}
+	}
+	return nil
+}
+
 func (gs *State) isPlayerInAttackRange(eeper *entities.EeperState) bool {
 	return gs.Player.Position.X >= eeper.Position.X && gs.Player.Position.X < eeper.Position.X+eeper.Size.X &&
 		gs.Player.Position.Y >= eeper.Position.Y && gs.Player.Position.Y < eeper.Position.Y+eeper.Size.Y
 }
 
-// updateGnome updates a gnome eeper that flees from the player
-func (gs *State) updateGnome(eeper *entities.EeperState) {
-	if eeper.Health <= 0 {
-		eeper.Dead = true
-		return
+// senseGnome recomputes the gnome's distance map to the player
+func (gs *State) senseGnome(eeper *entities.EeperState, turn *EeperTurn) bool {
+	if !gs.senseDeath(eeper, turn) {
+		return false
 	}
 
-	// Store the current position before any movement
-	oldPosition := eeper.Position
-	oldEyes := eeper.Eyes
-
 	// Recompute path for gnome (they use different pathfinding params)
 	gs.recomputePathForGnome(eeper)
 
 	// Check if player is reachable
-	currentDist := eeper.Path[eeper.Position.Y][eeper.Position.X]
+	turn.Distance = eeper.Path[eeper.Position.Y][eeper.Position.X]
+	return true
+}
 
-	if currentDist >= 0 {
-		// Player is reachable - gnome flees (moves to higher distance)
-		gs.moveGnomeAwayFromPlayer(eeper)
-		eeper.Eyes = entities.EyesOpen
-		eeper.EyesTarget = gs.Player.Position
-	} else {
-		// Player not reachable - gnome sleeps
-		eeper.Eyes = entities.EyesClosed
-		eeper.EyesTarget = world.IVector2{
-			X: eeper.Position.X + eeper.Size.X/2,
-			Y: eeper.Position.Y + eeper.Size.Y,
-		}
+// gnomeFlee moves a gnome that can reach the player away from them
+func (gs *State) gnomeFlee(eeper *entities.EeperState, turn *EeperTurn) {
+	gs.moveGnomeAwayFromPlayer(eeper)
+	eeper.Eyes = entities.EyesOpen
+	eeper.EyesTarget = gs.Player.Position
+}
+
+// gnomeInvestigate makes a gnome woken up by a noise sneak towards its source
+func (gs *State) gnomeInvestigate(eeper *entities.EeperState, turn *EeperTurn) {
+	if !gs.stepEeperToward(eeper, eeper.LastKnownPosition, eeper.Size) {
+		eeper.Behaviour = entities.BehaviourSleep
+	}
+	eeper.Eyes = entities.EyesOpen
+	eeper.EyesTarget = eeper.LastKnownPosition
+}
+
+// gnomeSleep puts a gnome that cannot reach the player to sleep
+func (gs *State) gnomeSleep(eeper *entities.EeperState, turn *EeperTurn) {
+	eeper.Behaviour = entities.BehaviourSleep
+	eeper.Eyes = entities.EyesClosed
+	eeper.EyesTarget = world.IVector2{
+		X: eeper.Position.X + eeper.Size.X/2,
+		Y: eeper.Position.Y + eeper.Size.Y,
+	}
+}
+
+// gnomeAfterMove slides a gnome that moved over ice, takes it through
+// teleporters and lets thieves steal what they stand on
+func (gs *State) gnomeAfterMove(eeper *entities.EeperState, turn *EeperTurn) {
+	// Gnomes slide over ice like the player
+	if eeper.Position != turn.OldPosition {
+		gs.slideGnome(eeper, turn.OldPosition)
 	}
 
-	// Set previous position AFTER all movement and state changes
-	eeper.PrevPosition = oldPosition
-	eeper.PrevEyes = oldEyes
+	// Escape through a teleporter pad, appearing instantly on the other side
+	if eeper.Position != turn.OldPosition && gs.teleportEeper(eeper) {
+		turn.OldPosition = eeper.Position
+	}
+
+	// Thieves grab whatever they end up standing on
+	if eeper.Thief {
+		gs.stealItems(eeper)
+	}
 }
 
-// moveGnomeAwayFromPlayer moves the gnome to a position farther from the player
+// moveGnomeAwayFromPlayer moves the gnome one step towards the safest cell it
+// can reach within a few steps: far from the player and not in a dead end
 func (gs *State) moveGnomeAwayFromPlayer(eeper *entities.EeperState) {
 	currentDist := eeper.Path[eeper.Position.Y][eeper.Position.X]
 	if currentDist < 0 {
 		return
 	}
 
-	// Find all adjacent positions with HIGHER distance (fleeing)
-	directions := []world.IVector2{
-		{X: 0, Y: 1},
-		{X: 0, Y: -1},
-		{X: 1, Y: 0},
-		{X: -1, Y: 0},
+	// Breadth-first search over the cells reachable within the lookahead,
+	// remembering the first step of the way to each of them
+	type reachedCell struct {
+		firstStep world.IVector2
+		steps     int
 	}
-	var availablePositions []world.IVector2
+	reached := map[world.IVector2]reachedCell{eeper.Position: {firstStep: eeper.Position}}
+	queue := []world.IVector2{eeper.Position}
 
-	for _, dir := range directions {
-		newPos := eeper.Position.Add(dir)
+	// Staying put is only chosen when nothing reachable is safer
+	bestScore := gs.gnomeFleeScore(eeper, eeper.Position)
+	var bestSteps []world.IVector2
 
-		// Check if position is valid
-		if !gs.WithinMap(newPos) {
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+		if reached[curr].steps >= gnomeFleeLookahead {
 			continue
 		}
 
-		// Check if it's a floor cell
-		if gs.Map[newPos.Y][newPos.X] != world.CellFloor {
-			continue
-		}
+		for _, dir := range Directions {
+			step := curr.Add(dir)
+			if !gs.gnomeCanStandHere(step, eeper) {
+				continue
+			}
+
+			// The gnome ends up where ice and conveyors take it, not on the cell it steps on
+			cells := gs.moveThroughTerrain(step, dir, eeper.Size, func(pos world.IVector2) bool {
+				return gs.gnomeCanStandHere(pos, eeper)
+			})
+			next := cells[len(cells)-1]
+			if _, seen := reached[next]; seen {
+				continue
+			}
 
-		// Gnomes flee - move to positions with HIGHER distance
-		if eeper.Path[newPos.Y][newPos.X] > currentDist {
-			availablePositions = append(availablePositions, newPos)
+			firstStep := reached[curr].firstStep
+			if curr == eeper.Position {
+				firstStep = step
+			}
+			reached[next] = reachedCell{firstStep: firstStep, steps: reached[curr].steps + 1}
+			queue = append(queue, next)
+
+			score := gs.gnomeFleeScore(eeper, next)
+			switch {
+			case score > bestScore:
+				bestScore = score
+				bestSteps = []world.IVector2{firstStep}
+			case score == bestScore && len(bestSteps) > 0:
+				bestSteps = append(bestSteps, firstStep)
+			}
 		}
 	}
 
 	// If found positions to flee to, pick one randomly
-	if len(availablePositions) > 0 {
-		eeper.Position = availablePositions[rand.Intn(len(availablePositions))]
+	if len(bestSteps) > 0 {
+		eeper.Position = bestSteps[rng.Intn(len(bestSteps))]
 	}
 }
 
-// recomputePathForGnome computes distance map for gnome (different params than guards)
+// gnomeCanStandHere checks whether a fleeing gnome can step on a cell.
+// Unlike the player, gnomes run through explosions.
+func (gs *State) gnomeCanStandHere(pos world.IVector2, eeper *entities.EeperState) bool {
+	return pos != gs.Player.Position && gs.eeperCanStandHere(pos, eeper)
+}
+
+// gnomeFleeScore rates how safe a cell is for a fleeing gnome. Cells farther
+// from the player are safer, cells with fewer ways out are more dangerous.
+func (gs *State) gnomeFleeScore(eeper *entities.EeperState, pos world.IVector2) int {
+	dist := eeper.Path[pos.Y][pos.X]
+	if dist < 0 {
+		// Out of the player's reach within the gnome's notice range
+		dist = gs.Rules.GnomeStepsLimit + 1
+	}
+
+	exits := 0
+	for _, dir := range Directions {
+		if gs.gnomeCanStandHere(pos.Add(dir), eeper) {
+			exits++
+		}
+	}
+
+	score := dist*4 + exits
+	if exits <= 1 {
+		score -= gnomeDeadEndPenalty
+	}
+	return score
+}
+
+// recomputePathForGnome computes distance map for gnome (different params than guards).
+// The player moves 1 cell per step, but slides over ice and rides conveyors.
 func (gs *State) recomputePathForGnome(eeper *entities.EeperState) {
-	canStand := func(p pathfinding.Point) bool {
-		pos := world.IVector2{X: p.X, Y: p.Y}
+	canEnter := func(pos world.IVector2) bool {
 		if !gs.WithinMap(pos) {
 			return false
 		}
-		return gs.Map[pos.Y][pos.X] == world.CellFloor
+		cell := gs.Map[pos.Y][pos.X]
+		return cell.IsWalkable() || cell == world.CellExplosion
+	}
+	canStand := func(p pathfinding.Point) bool {
+		return canEnter(world.IVector2{X: p.X, Y: p.Y})
+	}
+	move := func(from pathfinding.Point, dir pathfinding.Point) []pathfinding.Point {
+		step := world.IVector2{X: from.X + dir.X, Y: from.Y + dir.Y}
+		if !canEnter(step) {
+			return nil
+		}
+		var points []pathfinding.Point
+		for _, cell := range gs.moveThroughTerrain(step, world.IVector2{X: dir.X, Y: dir.Y}, world.IVector2{X: 1, Y: 1}, canEnter) {
+			points = append(points, pathfinding.Point{X: cell.X, Y: cell.Y})
+		}
+		return points
 	}
 
-	eeper.Path = pathfinding.ComputeDistanceMap(
+	eeper.Path = pathfinding.ComputeDistanceMapWithMoves(
 		gs.Map,
 		pathfinding.Point{X: gs.Player.Position.X, Y: gs.Player.Position.Y},
 		pathfinding.Point{X: eeper.Size.X, Y: eeper.Size.Y},
-		gnomeStepsLimit,      // 9 steps
-		gnomeStepLengthLimit, // 1 cell per step
+		gs.Rules.GnomeStepsLimit, // How far away the gnome notices the player
 		canStand,
+		move,
 	)
 }
 
 // SpawnGuard creates a new guard at the specified position
 func (gs *State) SpawnGuard(position world.IVector2) {
-	size := world.IVector2{X: 3, Y: 3}
+	size := eeperSizes[entities.EeperGuard]
 
 	// Initialize path map with correct dimensions
 	height := len(gs.Map)
@@ -404,6 +563,7 @@ func (gs *State) SpawnGuard(position world.IVector2) {
 		Kind:           entities.EeperGuard,
 		Dead:           false,
 		Position:       position,
+		Post:           position,
 		PrevPosition:   position,
 		EyesAngle:      0,
 		EyesTarget:     world.IVector2{X: position.X + size.X/2, Y: position.Y + size.Y},
@@ -413,7 +573,7 @@ func (gs *State) SpawnGuard(position world.IVector2) {
 		Path:           path,
 		Damaged:        false,
 		Health:         1.0,
-		AttackCooldown: guardAttackCooldown,
+		AttackCooldown: gs.Rules.GuardAttackCooldown,
 	}
 
 	gs.Eepers = append(gs.Eepers, guard)
@@ -421,7 +581,7 @@ func (gs *State) SpawnGuard(position world.IVector2) {
 
 // SpawnGnome creates a new gnome at the specified position
 func (gs *State) SpawnGnome(position world.IVector2) {
-	size := world.IVector2{X: 1, Y: 1} // Gnomes are 1x1
+	size := eeperSizes[entities.EeperGnome]
 
 	// Initialize path map with correct dimensions
 	height := len(gs.Map)
@@ -454,7 +614,7 @@ func (gs *State) SpawnGnome(position world.IVector2) {
 
 // SpawnMother creates a new Mother eeper at the specified position
 func (gs *State) SpawnMother(position world.IVector2) {
-	size := world.IVector2{X: 7, Y: 7} // Mothers are 7x7 (large)
+	size := eeperSizes[entities.EeperMother]
 
 	// Initialize path map with correct dimensions
 	height := len(gs.Map)
@@ -471,6 +631,7 @@ func (gs *State) SpawnMother(position world.IVector2) {
 		Kind:           entities.EeperMother,
 		Dead:           false,
 		Position:       position,
+		Post:           position,
 		PrevPosition:   position,
 		EyesAngle:      0,
 		EyesTarget:     world.IVector2{X: position.X + size.X/2, Y: position.Y + size.Y},
@@ -480,7 +641,9 @@ func (gs *State) SpawnMother(position world.IVector2) {
 		Path:           path,
 		Damaged:        false,
 		Health:         1.0,
-		AttackCooldown: guardAttackCooldown,
+		AttackCooldown: gs.Rules.GuardAttackCooldown,
+		ChildKind:      entities.EeperGuard,
+		ChildCount:     4,
 	}
 
 	gs.Eepers = append(gs.Eepers, mother)
@@ -488,7 +651,7 @@ func (gs *State) SpawnMother(position world.IVector2) {
 
 // SpawnFather creates a new Father eeper at the specified position - the goal!
 func (gs *State) SpawnFather(position world.IVector2) {
-	size := world.IVector2{X: 7, Y: 7} // Father is 7x7 (large, same as Mother)
+	size := eeperSizes[entities.EeperFather]
 
 	// Father doesn't need path map (doesn't chase)
 	father := entities.EeperState{
@@ -0,0 +1,52 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+)
+
+// iceLaneState builds a walled room with an ice lane from x=3 to x=8 along
+// y=2, walled in on both sides except for a nook above its middle, the player
+// at its left end and a gnome at its right end
+func iceLaneState() *State {
+	const width, height = 12, 5
+
+	gs := &State{Rules: DefaultRules()}
+	gs.Map = make([][]world.Cell, height)
+	for y := range gs.Map {
+		gs.Map[y] = make([]world.Cell, width)
+		for x := range gs.Map[y] {
+			switch {
+			case y == 0 || y == height-1 || x == 0 || x == width-1:
+				gs.Map[y][x] = world.CellWall
+			case x >= 3 && x <= 8 && y == 2:
+				gs.Map[y][x] = world.CellIce
+			case x >= 3 && x <= 8 && !(x == 5 && y == 1):
+				gs.Map[y][x] = world.CellWall
+			default:
+				gs.Map[y][x] = world.CellFloor
+			}
+		}
+	}
+
+	gs.Player.Position = world.IVector2{X: 2, Y: 2}
+	gs.SpawnGnome(world.IVector2{X: 10, Y: 2})
+	return gs
+}
+
+func TestGnomePathSlidesOverIce(t *testing.T) {
+	gs := iceLaneState()
+	gnome := &gs.Eepers[0]
+	gs.recomputePathForGnome(gnome)
+
+	if dist := gnome.Path[2][9]; dist != 1 {
+		t.Errorf("distance past the ice lane is %d, want 1", dist)
+	}
+	if dist := gnome.Path[2][5]; dist != 1 {
+		t.Errorf("distance on the ice lane is %d, want 1", dist)
+	}
+	if dist := gnome.Path[1][5]; dist != -1 {
+		t.Errorf("distance to the nook the player slides past is %d, want unreachable", dist)
+	}
+}
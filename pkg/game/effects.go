@@ -0,0 +1,43 @@
+package game
+
+import (
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+)
+
+// applyEffect puts the effect on the eeper for the given number of turns,
+// keeping a longer duration it already has
+func (gs *State) applyEffect(eeper *entities.EeperState, effect entities.StatusEffect, turns int) {
+	if turns > eeper.Effects[effect] {
+		eeper.Effects[effect] = turns
+	}
+}
+
+// tickEffects counts down the eeper's status effects at the end of its turn
+func (gs *State) tickEffects(eeper *entities.EeperState) {
+	// Eepers coming round from a stun stay groggy for a while
+	comingRound := eeper.Effects[entities.EffectStunned] == 1
+
+	for effect := range eeper.Effects {
+		if eeper.Effects[effect] > 0 {
+			eeper.Effects[effect]--
+		}
+	}
+
+	if comingRound {
+		gs.applyEffect(eeper, entities.EffectSlowed, gs.Rules.SlowTurns)
+	}
+}
+
+// stalled holds while a status effect keeps the eeper from acting this turn:
+// it is stunned, or slowed and waiting for its next turn
+func (gs *State) stalled(eeper *entities.EeperState, turn *EeperTurn) bool {
+	slowed := eeper.Effects[entities.EffectSlowed]
+	return eeper.Effects[entities.EffectStunned] > 0 || (slowed > 0 && slowed%2 == 0)
+}
+
+// stallEeper lets a stunned or slowed eeper skip its turn
+func (gs *State) stallEeper(eeper *entities.EeperState, turn *EeperTurn) {
+	if eeper.Effects[entities.EffectStunned] > 0 {
+		eeper.Eyes = entities.EyesDizzy
+	}
+}
@@ -9,7 +9,10 @@ func (gs *State) UpdateExplosions() {
 		explosion.Timer--
 
 		if explosion.Timer <= 0 {
-			gs.Map[explosion.Position.Y][explosion.Position.X] = world.CellFloor
+			// Wiring cells are never replaced by explosions, so leave them alone
+			if gs.Map[explosion.Position.Y][explosion.Position.X] == world.CellExplosion {
+				gs.Map[explosion.Position.Y][explosion.Position.X] = world.CellFloor
+			}
 			gs.Explosions = append(gs.Explosions[:i], gs.Explosions[i+1:]...)
 		}
 	}
@@ -9,4 +9,11 @@ func (gs *State) ItemsTurn() {
 			item.Cooldown--
 		}
 	}
+
+	// Teleporter pads rest for a few turns after being used
+	for i := range gs.Teleporters {
+		if gs.Teleporters[i].Cooldown > 0 {
+			gs.Teleporters[i].Cooldown--
+		}
+	}
 }
@@ -0,0 +1,21 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+)
+
+// assignGnomeKeys colours the keys dropped by the gnomes spawned at the
+// positions configured for the level
+func (gs *State) assignGnomeKeys() error {
+	for _, gnomeKey := range gs.LevelConfig.GnomeKeys {
+		eeper := gs.eeperAt(gnomeKey.Gnome, entities.EeperGnome)
+		if eeper == nil {
+			return fmt.Errorf("level %s: no gnome at %d,%d for key colour", gs.CurrentLevelPath, gnomeKey.Gnome.X, gnomeKey.Gnome.Y)
+		}
+		eeper.KeyColor = gnomeKey.Color
+	}
+
+	return nil
+}
@@ -32,28 +32,96 @@ const (
 	LevelPortal2
 	LevelPortal3
 	LevelPortal4
+	LevelHeal
+	LevelDoorRed
+	LevelDoorGreen
+	LevelDoorYellow
+	LevelKeyRed
+	LevelKeyGreen
+	LevelKeyYellow
+	LevelPlate
+	LevelLever
+	LevelGateClosed
+	LevelGateOpen
+	LevelBlock
+	LevelTeleporter1
+	LevelTeleporter2
+	LevelTeleporter3
+	LevelTeleporter4
+	LevelBombRemote
+	LevelBombLongFuse
+	LevelBombCross
+	LevelPowerBlastRange
+	LevelPowerShortFuse
+	LevelPowerKick
+	LevelPowerImmunity
+	LevelIce
+	LevelConveyorUp
+	LevelConveyorDown
+	LevelConveyorLeft
+	LevelConveyorRight
+	LevelPit
+	LevelBoss
+	LevelWeakPoint
+	LevelBomber
+	LevelMirror
+	LevelThief
 )
 
 // LevelCellColor maps level cell types to their corresponding colors.
 var LevelCellColor = map[LevelCell]rl.Color{
-	LevelNone:       rl.NewColor(0, 0, 0, 0),
-	LevelGnome:      rl.NewColor(255, 150, 0, 255),
-	LevelMother:     rl.NewColor(150, 255, 0, 255),
-	LevelGuard:      rl.NewColor(0, 255, 0, 255),
-	LevelFloor:      rl.NewColor(255, 255, 255, 255),
-	LevelWall:       rl.NewColor(0, 0, 0, 255),
-	LevelDoor:       rl.NewColor(0, 255, 255, 255),
-	LevelCheckpoint: rl.NewColor(255, 0, 255, 255),
-	LevelBombRefill: rl.NewColor(255, 0, 0, 255),
-	LevelBarricade:  rl.NewColor(255, 0, 150, 255),
-	LevelKey:        rl.NewColor(255, 255, 0, 255),
-	LevelPlayer:     rl.NewColor(0, 0, 255, 255),
-	LevelFather:     rl.NewColor(38, 95, 218, 255),
-	LevelBombSlot:   rl.NewColor(188, 83, 83, 255),
-	LevelPortal1:    rl.NewColor(16, 0, 0, 255),
-	LevelPortal2:    rl.NewColor(32, 0, 0, 255),
-	LevelPortal3:    rl.NewColor(48, 0, 0, 255),
-	LevelPortal4:    rl.NewColor(64, 0, 0, 255),
+	LevelNone:            rl.NewColor(0, 0, 0, 0),
+	LevelGnome:           rl.NewColor(255, 150, 0, 255),
+	LevelMother:          rl.NewColor(150, 255, 0, 255),
+	LevelGuard:           rl.NewColor(0, 255, 0, 255),
+	LevelFloor:           rl.NewColor(255, 255, 255, 255),
+	LevelWall:            rl.NewColor(0, 0, 0, 255),
+	LevelDoor:            rl.NewColor(0, 255, 255, 255),
+	LevelCheckpoint:      rl.NewColor(255, 0, 255, 255),
+	LevelBombRefill:      rl.NewColor(255, 0, 0, 255),
+	LevelBarricade:       rl.NewColor(255, 0, 150, 255),
+	LevelKey:             rl.NewColor(255, 255, 0, 255),
+	LevelPlayer:          rl.NewColor(0, 0, 255, 255),
+	LevelFather:          rl.NewColor(38, 95, 218, 255),
+	LevelBombSlot:        rl.NewColor(188, 83, 83, 255),
+	LevelPortal1:         rl.NewColor(16, 0, 0, 255),
+	LevelPortal2:         rl.NewColor(32, 0, 0, 255),
+	LevelPortal3:         rl.NewColor(48, 0, 0, 255),
+	LevelPortal4:         rl.NewColor(64, 0, 0, 255),
+	LevelHeal:            rl.NewColor(0, 255, 150, 255),
+	LevelDoorRed:         rl.NewColor(0, 200, 200, 255),
+	LevelDoorGreen:       rl.NewColor(0, 150, 150, 255),
+	LevelDoorYellow:      rl.NewColor(0, 100, 100, 255),
+	LevelKeyRed:          rl.NewColor(200, 200, 0, 255),
+	LevelKeyGreen:        rl.NewColor(150, 150, 0, 255),
+	LevelKeyYellow:       rl.NewColor(100, 100, 0, 255),
+	LevelPlate:           rl.NewColor(128, 128, 128, 255),
+	LevelLever:           rl.NewColor(64, 64, 64, 255),
+	LevelGateClosed:      rl.NewColor(100, 0, 100, 255),
+	LevelGateOpen:        rl.NewColor(200, 100, 200, 255),
+	LevelBlock:           rl.NewColor(150, 75, 0, 255),
+	LevelTeleporter1:     rl.NewColor(0, 0, 16, 255),
+	LevelTeleporter2:     rl.NewColor(0, 0, 32, 255),
+	LevelTeleporter3:     rl.NewColor(0, 0, 48, 255),
+	LevelTeleporter4:     rl.NewColor(0, 0, 64, 255),
+	LevelBombRemote:      rl.NewColor(255, 100, 100, 255),
+	LevelBombLongFuse:    rl.NewColor(200, 0, 0, 255),
+	LevelBombCross:       rl.NewColor(150, 0, 0, 255),
+	LevelPowerBlastRange: rl.NewColor(0, 128, 255, 255),
+	LevelPowerShortFuse:  rl.NewColor(0, 128, 192, 255),
+	LevelPowerKick:       rl.NewColor(0, 128, 128, 255),
+	LevelPowerImmunity:   rl.NewColor(0, 128, 64, 255),
+	LevelIce:             rl.NewColor(200, 255, 255, 255),
+	LevelConveyorUp:      rl.NewColor(128, 64, 0, 255),
+	LevelConveyorDown:    rl.NewColor(128, 64, 32, 255),
+	LevelConveyorLeft:    rl.NewColor(128, 64, 64, 255),
+	LevelConveyorRight:   rl.NewColor(128, 64, 96, 255),
+	LevelPit:             rl.NewColor(32, 32, 32, 255),
+	LevelBoss:            rl.NewColor(255, 50, 50, 255),
+	LevelWeakPoint:       rl.NewColor(200, 0, 100, 255),
+	LevelBomber:          rl.NewColor(100, 200, 0, 255),
+	LevelMirror:          rl.NewColor(128, 0, 255, 255),
+	LevelThief:           rl.NewColor(255, 100, 0, 255),
 }
 
 // LoadGameFromImage loads a game state from an image file.
@@ -98,8 +166,38 @@ func LoadGameFromImage(filePath string, gs *State, updatePlayer bool) error {
 				gs.Map[y][x] = world.CellWall
 			case LevelDoor:
 				gs.Map[y][x] = world.CellDoor
+			case LevelDoorRed:
+				gs.Map[y][x] = world.CellDoorRed
+			case LevelDoorGreen:
+				gs.Map[y][x] = world.CellDoorGreen
+			case LevelDoorYellow:
+				gs.Map[y][x] = world.CellDoorYellow
 			case LevelBarricade:
 				gs.Map[y][x] = world.CellBarricade
+			case LevelPlate:
+				gs.Map[y][x] = world.CellPlate
+			case LevelLever:
+				gs.Map[y][x] = world.CellLever
+			case LevelGateClosed:
+				gs.Map[y][x] = world.CellGateClosed
+			case LevelGateOpen:
+				gs.Map[y][x] = world.CellGateOpen
+			case LevelBlock:
+				gs.SpawnBlock(world.IVector2{X: x, Y: y})
+			case LevelIce:
+				gs.Map[y][x] = world.CellIce
+			case LevelConveyorUp:
+				gs.Map[y][x] = world.CellConveyorUp
+			case LevelConveyorDown:
+				gs.Map[y][x] = world.CellConveyorDown
+			case LevelConveyorLeft:
+				gs.Map[y][x] = world.CellConveyorLeft
+			case LevelConveyorRight:
+				gs.Map[y][x] = world.CellConveyorRight
+			case LevelPit:
+				gs.Map[y][x] = world.CellPit
+			case LevelWeakPoint:
+				gs.Map[y][x] = world.CellWeakPoint
 			case LevelCheckpoint:
 				gs.Map[y][x] = world.CellFloor
 				gs.AllocateItem(world.IVector2{X: x, Y: y}, entities.ItemCheckpoint)
@@ -109,15 +207,60 @@ func LoadGameFromImage(filePath string, gs *State, updatePlayer bool) error {
 			case LevelBombSlot:
 				gs.Map[y][x] = world.CellFloor
 				gs.AllocateItem(world.IVector2{X: x, Y: y}, entities.ItemBombSlot)
+			case LevelBombRemote:
+				gs.Map[y][x] = world.CellFloor
+				gs.AllocateItem(world.IVector2{X: x, Y: y}, entities.ItemBombRemote)
+			case LevelBombLongFuse:
+				gs.Map[y][x] = world.CellFloor
+				gs.AllocateItem(world.IVector2{X: x, Y: y}, entities.ItemBombLongFuse)
+			case LevelBombCross:
+				gs.Map[y][x] = world.CellFloor
+				gs.AllocateItem(world.IVector2{X: x, Y: y}, entities.ItemBombCross)
+			case LevelPowerBlastRange:
+				gs.Map[y][x] = world.CellFloor
+				gs.AllocateItem(world.IVector2{X: x, Y: y}, entities.ItemPowerBlastRange)
+			case LevelPowerShortFuse:
+				gs.Map[y][x] = world.CellFloor
+				gs.AllocateItem(world.IVector2{X: x, Y: y}, entities.ItemPowerShortFuse)
+			case LevelPowerKick:
+				gs.Map[y][x] = world.CellFloor
+				gs.AllocateItem(world.IVector2{X: x, Y: y}, entities.ItemPowerKick)
+			case LevelPowerImmunity:
+				gs.Map[y][x] = world.CellFloor
+				gs.AllocateItem(world.IVector2{X: x, Y: y}, entities.ItemPowerImmunity)
+			case LevelHeal:
+				gs.Map[y][x] = world.CellFloor
+				gs.AllocateItem(world.IVector2{X: x, Y: y}, entities.ItemHeal)
 			case LevelKey:
 				gs.Map[y][x] = world.CellFloor
-				gs.AllocateItem(world.IVector2{X: x, Y: y}, entities.ItemKey)
+				gs.AllocateKey(world.IVector2{X: x, Y: y}, world.KeyCyan)
+			case LevelKeyRed:
+				gs.Map[y][x] = world.CellFloor
+				gs.AllocateKey(world.IVector2{X: x, Y: y}, world.KeyRed)
+			case LevelKeyGreen:
+				gs.Map[y][x] = world.CellFloor
+				gs.AllocateKey(world.IVector2{X: x, Y: y}, world.KeyGreen)
+			case LevelKeyYellow:
+				gs.Map[y][x] = world.CellFloor
+				gs.AllocateKey(world.IVector2{X: x, Y: y}, world.KeyYellow)
 			case LevelGuard:
 				gs.Map[y][x] = world.CellFloor
 				gs.SpawnGuard(world.IVector2{X: x, Y: y})
 			case LevelMother:
 				gs.Map[y][x] = world.CellFloor
 				gs.SpawnMother(world.IVector2{X: x, Y: y})
+			case LevelThief:
+				gs.Map[y][x] = world.CellFloor
+				gs.SpawnThief(world.IVector2{X: x, Y: y})
+			case LevelMirror:
+				gs.Map[y][x] = world.CellFloor
+				gs.SpawnMirror(world.IVector2{X: x, Y: y})
+			case LevelBomber:
+				gs.Map[y][x] = world.CellFloor
+				gs.SpawnBomber(world.IVector2{X: x, Y: y})
+			case LevelBoss:
+				gs.Map[y][x] = world.CellFloor
+				gs.SpawnBoss(world.IVector2{X: x, Y: y})
 			case LevelGnome:
 				gs.Map[y][x] = world.CellFloor
 				gs.SpawnGnome(world.IVector2{X: x, Y: y})
@@ -149,6 +292,18 @@ func LoadGameFromImage(filePath string, gs *State, updatePlayer bool) error {
 			case LevelPortal4:
 				gs.Map[y][x] = world.CellFloor
 				gs.SpawnPortal(world.IVector2{X: x, Y: y}, 4)
+			case LevelTeleporter1:
+				gs.Map[y][x] = world.CellFloor
+				gs.SpawnTeleporter(world.IVector2{X: x, Y: y}, 1)
+			case LevelTeleporter2:
+				gs.Map[y][x] = world.CellFloor
+				gs.SpawnTeleporter(world.IVector2{X: x, Y: y}, 2)
+			case LevelTeleporter3:
+				gs.Map[y][x] = world.CellFloor
+				gs.SpawnTeleporter(world.IVector2{X: x, Y: y}, 3)
+			case LevelTeleporter4:
+				gs.Map[y][x] = world.CellFloor
+				gs.SpawnTeleporter(world.IVector2{X: x, Y: y}, 4)
 			default:
 				gs.Map[y][x] = world.CellFloor
 			}
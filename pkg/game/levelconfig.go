@@ -0,0 +1,107 @@
+package game
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+)
+
+// LevelConfig holds optional per-level settings that cannot be expressed with
+// pixel colours. It is loaded from a JSON file next to the level image with the
+// same base name (e.g. levels/3.json for levels/3.png); keys use the Go field names.
+type LevelConfig struct {
+	OneHitDeath bool             // Classic mode for puzzle levels: any damage kills the player
+	RealTime    bool             // The world advances on a timer instead of with the player's moves
+	Rules       json.RawMessage  // Rules fields overriding the difficulty preset for this level
+	Patrols     []PatrolConfig   // Patrol routes of guards
+	GnomeKeys   []GnomeKeyConfig // Colours of the keys dropped by gnomes (cyan when not listed)
+	Wires       []WireConfig     // Links from plates and levers to the gates they toggle
+	Boss        BossConfig       // Phases of the level's boss
+	Mirrors     []MirrorConfig   // Axes flipped by mirrors (none when not listed)
+	Mothers     []MotherConfig   // What mothers split into (4 guards when not listed)
+	Abilities   []AbilityConfig  // Doors and barricades guards can get through
+}
+
+// AbilityConfig lets the guard (or mother) spawned at Eeper open doors and break barricades.
+type AbilityConfig struct {
+	Eeper            world.IVector2   // Pixel position of the eeper in the level image
+	Keys             []world.KeyColor // Keys carried by the eeper, one door opened per key
+	BreaksBarricades bool             // Smash barricades after standing at them for BarricadeBreakTurns turns
+}
+
+// MotherConfig sets what the mother spawned at Mother splits into when killed.
+type MotherConfig struct {
+	Mother     world.IVector2 // Pixel position of the mother in the level image
+	ChildKind  string         // Eeper kind by name, e.g. Guard or Gnome
+	ChildCount int            // Number of eepers spawned (4 when left out)
+}
+
+// MirrorConfig sets the axes along which the mirror spawned at Mirror flips the player's moves.
+type MirrorConfig struct {
+	Mirror world.IVector2 // Pixel position of the mirror in the level image
+	FlipX  bool           // Flip left and right moves
+	FlipY  bool           // Flip up and down moves
+}
+
+// BossConfig describes the phases a boss goes through as it loses health.
+type BossConfig struct {
+	Phases []BossPhaseConfig // Phases in order, the first one is active at full health
+}
+
+// BossPhaseConfig holds the behaviour of a boss during one phase.
+type BossPhaseConfig struct {
+	Health        float32          // Health at which the phase starts (ignored for the first phase)
+	GnomeInterval int              // Turns between gnomes spawned by the boss, 0 for none
+	BombInterval  int              // Turns between bombs thrown at the player, 0 for none
+	WeakPoints    []world.IVector2 // Weak point cells that must be blown up before the boss can be hurt
+}
+
+// WireConfig links plate and lever cells to the gate cells they toggle.
+type WireConfig struct {
+	Triggers []world.IVector2 // Positions of the plates and levers
+	Gates    []world.IVector2 // Positions of the gates
+}
+
+// GnomeKeyConfig sets the colour of the key dropped by the gnome spawned at Gnome.
+type GnomeKeyConfig struct {
+	Gnome world.IVector2 // Pixel position of the gnome in the level image
+	Color world.KeyColor // Key colour by name: Cyan, Red, Green or Yellow
+}
+
+// PatrolConfig assigns a patrol route to the guard (or mother) spawned at Guard.
+type PatrolConfig struct {
+	Guard     world.IVector2   // Pixel position of the guard in the level image
+	Waypoints []world.IVector2 // Top-left positions the guard walks through in a loop
+}
+
+// LevelConfigPath returns the path of the config file belonging to a level image
+func LevelConfigPath(levelPath string) string {
+	return strings.TrimSuffix(levelPath, filepath.Ext(levelPath)) + ".json"
+}
+
+// LoadLevelConfig loads the config of a level, returning the defaults when the
+// level has no config file
+func LoadLevelConfig(levelPath string) (LevelConfig, error) {
+	var config LevelConfig
+
+	configPath := LevelConfigPath(levelPath)
+	data, err := os.ReadFile(configPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return config, nil
+	}
+	if err != nil {
+		return config, fmt.Errorf("could not load level config from file %s: %w", configPath, err)
+	}
+
+	if err := json.Unmarshal(data, &config); err != nil {
+		return config, fmt.Errorf("could not parse level config %s: %w", configPath, err)
+	}
+
+	return config, nil
+}
@@ -1,6 +1,8 @@
 package game
 
 import (
+	"fmt"
+
 	"github.com/engpetarmarinov/eepers-go/pkg/palette"
 	rl "github.com/gen2brain/raylib-go/raylib"
 )
@@ -12,6 +14,9 @@ const (
 	MenuContinue MenuOption = iota
 	MenuExitLevel
 	MenuRestart
+	MenuDifficulty
+	MenuVisionCones
+	MenuRealTime
 	MenuQuit
 )
 
@@ -27,7 +32,7 @@ func NewMenuState() MenuState {
 	return MenuState{
 		IsOpen:         false,
 		SelectedOption: MenuContinue,
-		TotalOptions:   4, // Continue, Exit Level, Restart, Quit
+		TotalOptions:   7, // Continue, Exit Level, Restart, Difficulty, Vision Cones, Real-Time, Quit
 	}
 }
 
@@ -111,6 +116,12 @@ func GetOptionText(option MenuOption) string {
 		return "Restart"
 	case MenuExitLevel:
 		return "Exit Level"
+	case MenuDifficulty:
+		return "Difficulty"
+	case MenuVisionCones:
+		return "Vision Cones"
+	case MenuRealTime:
+		return "Real-Time"
 	case MenuQuit:
 		return "Quit"
 	default:
@@ -118,7 +129,8 @@ func GetOptionText(option MenuOption) string {
 	}
 }
 
-func (ms *MenuState) DrawMenu(inHub bool) {
+// DrawMenu draws the pause menu, showing the current values of the settings
+func (ms *MenuState) DrawMenu(inHub bool, settings Settings) {
 	if !ms.IsOpen {
 		return
 	}
@@ -162,9 +174,9 @@ func (ms *MenuState) DrawMenu(inHub bool) {
 	if optionSize < 30 {
 		optionSize = 30
 	}
-	optionSpacing := menuHeight / 6
-	if optionSpacing < 50 {
-		optionSpacing = 50
+	optionSpacing := menuHeight * 2 / 3 / int32(ms.TotalOptions)
+	if optionSpacing < 45 {
+		optionSpacing = 45
 	}
 
 	// Draw title
@@ -185,6 +197,22 @@ func (ms *MenuState) DrawMenu(inHub bool) {
 		}
 
 		optionText := GetOptionText(i)
+		switch i {
+		case MenuDifficulty:
+			optionText = fmt.Sprintf("%s: %s", optionText, settings.Difficulty)
+		case MenuVisionCones:
+			if settings.ShowVisionCones {
+				optionText += ": On"
+			} else {
+				optionText += ": Off"
+			}
+		case MenuRealTime:
+			if settings.RealTime {
+				optionText += ": On"
+			} else {
+				optionText += ": Off"
+			}
+		}
 		textWidth := rl.MeasureText(optionText, optionSize)
 		textX := menuX + (menuWidth-textWidth)/2
 		textY := optionY + optionIndex*optionSpacing
@@ -0,0 +1,79 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+)
+
+// SpawnMirror creates a new mirror eeper at the specified position
+func (gs *State) SpawnMirror(position world.IVector2) {
+	size := eeperSizes[entities.EeperMirror]
+
+	// Mirror doesn't need path map (it only copies the player's moves)
+	mirror := entities.EeperState{
+		Kind:         entities.EeperMirror,
+		Dead:         false,
+		Position:     position,
+		PrevPosition: position,
+		EyesAngle:    0,
+		EyesTarget:   world.IVector2{X: position.X, Y: position.Y + 1},
+		PrevEyes:     entities.EyesOpen,
+		Eyes:         entities.EyesOpen,
+		Size:         size,
+		Path:         nil,
+		Damaged:      false,
+		Health:       1.0, // Dies in one explosion hit
+	}
+
+	gs.Eepers = append(gs.Eepers, mirror)
+}
+
+// assignMirrors sets the axes along which the mirrors spawned at the
+// configured positions flip the player's moves
+func (gs *State) assignMirrors() error {
+	for _, config := range gs.LevelConfig.Mirrors {
+		eeper := gs.eeperAt(config.Mirror, entities.EeperMirror)
+		if eeper == nil {
+			return fmt.Errorf("level %s: no mirror at %d,%d for flip", gs.CurrentLevelPath, config.Mirror.X, config.Mirror.Y)
+		}
+		eeper.MirrorFlipX = config.FlipX
+		eeper.MirrorFlipY = config.FlipY
+	}
+
+	return nil
+}
+
+// mirrorMove moves a mirror eeper in the directions of the player's moves
+// since the last world turn, flipped along its axes, and kills the player on contact
+func (gs *State) mirrorMove(eeper *entities.EeperState, turn *EeperTurn) {
+	// The player walked into the mirror
+	if gs.isPlayerInAttackRange(eeper) {
+		gs.KillPlayer()
+		return
+	}
+
+	eeper.Eyes = entities.EyesOpen
+	for _, dir := range gs.Player.Moves {
+		if eeper.MirrorFlipX {
+			dir.X = -dir.X
+		}
+		if eeper.MirrorFlipY {
+			dir.Y = -dir.Y
+		}
+
+		// Copy the move if nothing is in the way, otherwise stay put like a player bumping into a wall
+		newPos := eeper.Position.Add(dir)
+		if gs.eeperCanStandHere(newPos, eeper) {
+			eeper.Position = newPos
+		}
+		eeper.EyesTarget = eeper.Position.Add(dir)
+
+		if gs.isPlayerInAttackRange(eeper) {
+			eeper.Eyes = entities.EyesAngry
+			gs.KillPlayer()
+			return
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+)
+
+// mirrorRoomState builds an empty walled room with a mirror at 3,2 and the
+// player at 10,2
+func mirrorRoomState() *State {
+	const width, height = 12, 5
+
+	gs := &State{Rules: DefaultRules()}
+	gs.Map = make([][]world.Cell, height)
+	for y := range gs.Map {
+		gs.Map[y] = make([]world.Cell, width)
+		for x := range gs.Map[y] {
+			if y == 0 || y == height-1 || x == 0 || x == width-1 {
+				gs.Map[y][x] = world.CellWall
+			} else {
+				gs.Map[y][x] = world.CellFloor
+			}
+		}
+	}
+
+	gs.Player.Position = world.IVector2{X: 10, Y: 2}
+	gs.SpawnMirror(world.IVector2{X: 3, Y: 2})
+	return gs
+}
+
+func TestMirrorCopiesEachMoveOnce(t *testing.T) {
+	gs := mirrorRoomState()
+	gs.Player.Moves = []world.IVector2{{X: 1, Y: 0}, {X: 0, Y: -1}}
+
+	gs.WorldTurn()
+	gs.WorldTurn()
+
+	want := world.IVector2{X: 4, Y: 1}
+	if got := gs.Eepers[0].Position; got != want {
+		t.Errorf("mirror at %d,%d after two world turns, want %d,%d", got.X, got.Y, want.X, want.Y)
+	}
+	if len(gs.Player.Moves) != 0 {
+		t.Errorf("%d player moves left after the world turn, want none", len(gs.Player.Moves))
+	}
+}
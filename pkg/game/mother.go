@@ -0,0 +1,130 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+)
+
+// spawnSearchRadius is how many steps away from its preferred position a
+// spawned eeper may be placed
+const spawnSearchRadius = 10
+
+// SpawnEeper creates a new eeper of the given kind at the specified position
+func (gs *State) SpawnEeper(kind entities.EeperKind, position world.IVector2) {
+	switch kind {
+	case entities.EeperGuard:
+		gs.SpawnGuard(position)
+	case entities.EeperMother:
+		gs.SpawnMother(position)
+	case entities.EeperGnome:
+		gs.SpawnGnome(position)
+	case entities.EeperFather:
+		gs.SpawnFather(position)
+	case entities.EeperBoss:
+		gs.SpawnBoss(position)
+	case entities.EeperBomber:
+		gs.SpawnBomber(position)
+	case entities.EeperMirror:
+		gs.SpawnMirror(position)
+	}
+}
+
+// assignMothers sets what the mothers spawned at the configured positions split into
+func (gs *State) assignMothers() error {
+	for _, config := range gs.LevelConfig.Mothers {
+		kind, err := entities.ParseEeperKind(config.ChildKind)
+		if err != nil {
+			return fmt.Errorf("level %s: mother at %d,%d: %w", gs.CurrentLevelPath, config.Mother.X, config.Mother.Y, err)
+		}
+		if _, found := eeperSizes[kind]; !found || kind == entities.EeperFather {
+			return fmt.Errorf("level %s: mother at %d,%d cannot split into %s", gs.CurrentLevelPath, config.Mother.X, config.Mother.Y, kind)
+		}
+		if config.ChildCount < 0 {
+			return fmt.Errorf("level %s: mother at %d,%d cannot split into %d children", gs.CurrentLevelPath, config.Mother.X, config.Mother.Y, config.ChildCount)
+		}
+
+		eeper := gs.eeperAt(config.Mother, entities.EeperMother)
+		if eeper == nil {
+			return fmt.Errorf("level %s: no mother at %d,%d for children", gs.CurrentLevelPath, config.Mother.X, config.Mother.Y)
+		}
+		eeper.ChildKind = kind
+		// Mothers keep their 4 children when the count is left out
+		if config.ChildCount > 0 {
+			eeper.ChildCount = config.ChildCount
+		}
+	}
+
+	return nil
+}
+
+// splitMother spawns the children of a killed mother, spread over the corners
+// of her footprint and moved to the closest free place when something is in
+// the way. The children grow out of the mother's center during the turn animation.
+func (gs *State) splitMother(mother entities.EeperState) {
+	size := eeperSizes[mother.ChildKind]
+	corners := []world.IVector2{
+		{X: 0, Y: 0},
+		{X: mother.Size.X - size.X, Y: 0},
+		{X: 0, Y: mother.Size.Y - size.Y},
+		{X: mother.Size.X - size.X, Y: mother.Size.Y - size.Y},
+	}
+	center := world.IVector2{
+		X: mother.Position.X + (mother.Size.X-size.X)/2,
+		Y: mother.Position.Y + (mother.Size.Y-size.Y)/2,
+	}
+
+	for i := 0; i < mother.ChildCount; i++ {
+		preferred := center
+		if i < len(corners) {
+			preferred = mother.Position.Add(corners[i])
+		}
+
+		position, found := gs.findSpawnPosition(preferred, size)
+		if !found {
+			continue // No room left around the mother
+		}
+
+		gs.SpawnEeper(mother.ChildKind, position)
+		child := &gs.Eepers[len(gs.Eepers)-1]
+		child.PrevPosition = center
+		child.Spawning = true
+	}
+}
+
+// findSpawnPosition searches breadth-first from the preferred position for the
+// closest place an eeper of the given size can stand without touching the player
+func (gs *State) findSpawnPosition(preferred world.IVector2, size world.IVector2) (world.IVector2, bool) {
+	// A probe eeper that is not in gs.Eepers collides with all of them
+	probe := &entities.EeperState{Size: size}
+
+	steps := map[world.IVector2]int{preferred: 0}
+	queue := []world.IVector2{preferred}
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+
+		if gs.eeperCanStandHere(curr, probe) && !gs.isInsideRect(curr, size, gs.Player.Position) {
+			return curr, true
+		}
+		if steps[curr] >= spawnSearchRadius {
+			continue
+		}
+
+		// Only search through open cells so children stay on the mother's side of walls
+		for _, dir := range Directions {
+			next := curr.Add(dir)
+			if _, seen := steps[next]; seen || !gs.WithinMap(next) {
+				continue
+			}
+			if cell := gs.Map[next.Y][next.X]; !cell.IsWalkable() && cell != world.CellExplosion {
+				continue
+			}
+			steps[next] = steps[curr] + 1
+			queue = append(queue, next)
+		}
+	}
+
+	return world.IVector2{}, false
+}
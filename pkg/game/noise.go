@@ -0,0 +1,96 @@
+package game
+
+import (
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+)
+
+// EmitNoise spreads a noise from the source through walkable cells up to the
+// given radius. Sleeping, patrolling or investigating eepers that hear it go
+// to investigate the source.
+func (gs *State) EmitNoise(source world.IVector2, radius int) {
+	if radius <= 0 || !gs.WithinMap(source) {
+		return
+	}
+
+	gs.Noises = append(gs.Noises, entities.NoiseState{
+		Position:     source,
+		Radius:       radius,
+		Timer:        30, // Ring spreads for 30 frames
+		InitialTimer: 30,
+	})
+
+	// Breadth-first search so walls block the noise and it has to travel around them
+	heard := map[world.IVector2]bool{source: true}
+	frontier := []world.IVector2{source}
+	for step := 0; step < radius && len(frontier) > 0; step++ {
+		var next []world.IVector2
+		for _, pos := range frontier {
+			for _, dir := range Directions {
+				neighbor := pos.Add(dir)
+				if heard[neighbor] || !gs.WithinMap(neighbor) || !gs.carriesNoise(gs.Map[neighbor.Y][neighbor.X]) {
+					continue
+				}
+				heard[neighbor] = true
+				next = append(next, neighbor)
+			}
+		}
+		frontier = next
+	}
+
+	for i := range gs.Eepers {
+		eeper := &gs.Eepers[i]
+		if eeper.Dead || !gs.eeperHearsNoise(eeper) {
+			continue
+		}
+		if !gs.footprintHears(eeper, heard) {
+			continue
+		}
+		eeper.Behaviour = entities.BehaviourInvestigate
+		eeper.LastKnownPosition = source
+	}
+}
+
+// carriesNoise checks whether a noise spreads through the cell: floor-like
+// cells and the fire of explosions burning on them, but not walls, the void or pits
+func (gs *State) carriesNoise(cell world.Cell) bool {
+	return cell.IsWalkable() || cell == world.CellExplosion
+}
+
+// eeperHearsNoise checks whether the eeper reacts to noises at all
+func (gs *State) eeperHearsNoise(eeper *entities.EeperState) bool {
+	switch eeper.Kind {
+	case entities.EeperGuard, entities.EeperMother, entities.EeperGnome, entities.EeperBoss, entities.EeperBomber:
+	default:
+		return false
+	}
+
+	switch eeper.Behaviour {
+	case entities.BehaviourSleep, entities.BehaviourPatrol, entities.BehaviourInvestigate, entities.BehaviourSearch, entities.BehaviourReturn:
+		return true
+	default:
+		return false // Already busy chasing or fleeing from the player
+	}
+}
+
+// footprintHears checks whether any cell of the eeper's footprint was reached by the noise
+func (gs *State) footprintHears(eeper *entities.EeperState, heard map[world.IVector2]bool) bool {
+	for y := 0; y < eeper.Size.Y; y++ {
+		for x := 0; x < eeper.Size.X; x++ {
+			if heard[eeper.Position.Add(world.IVector2{X: x, Y: y})] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// UpdateNoises advances the noise ring animations and removes finished ones
+func (gs *State) UpdateNoises() {
+	for i := len(gs.Noises) - 1; i >= 0; i-- {
+		gs.Noises[i].Timer--
+		if gs.Noises[i].Timer <= 0 {
+			gs.Noises = append(gs.Noises[:i], gs.Noises[i+1:]...)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+)
+
+// splitRoomState builds a walled room three cells high cut in two by a column
+// of the given cell at x=6, with a sleeping guard at 1,1 in its left half
+func splitRoomState(divider world.Cell) *State {
+	const width, height = 12, 5
+
+	gs := &State{Rules: DefaultRules()}
+	gs.Map = make([][]world.Cell, height)
+	for y := range gs.Map {
+		gs.Map[y] = make([]world.Cell, width)
+		for x := range gs.Map[y] {
+			switch {
+			case y == 0 || y == height-1 || x == 0 || x == width-1:
+				gs.Map[y][x] = world.CellWall
+			case x == 6:
+				gs.Map[y][x] = divider
+			default:
+				gs.Map[y][x] = world.CellFloor
+			}
+		}
+	}
+
+	gs.SpawnGuard(world.IVector2{X: 1, Y: 1})
+	return gs
+}
+
+func TestNoiseSpreadsOverFloor(t *testing.T) {
+	gs := splitRoomState(world.CellFloor)
+
+	gs.EmitNoise(world.IVector2{X: 10, Y: 2}, 10)
+
+	if behaviour := gs.Eepers[0].Behaviour; behaviour != entities.BehaviourInvestigate {
+		t.Errorf("guard behaviour is %v after a noise across the floor, want investigate", behaviour)
+	}
+}
+
+func TestNoiseDoesNotCrossVoid(t *testing.T) {
+	gs := splitRoomState(world.CellNone)
+
+	gs.EmitNoise(world.IVector2{X: 10, Y: 2}, 10)
+
+	if behaviour := gs.Eepers[0].Behaviour; behaviour != entities.BehaviourSleep {
+		t.Errorf("guard behaviour is %v after a noise across the void, want sleep", behaviour)
+	}
+}
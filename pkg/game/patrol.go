@@ -0,0 +1,88 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/pathfinding"
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+)
+
+// assignPatrols assigns the patrol routes from the level config to the guards
+// spawned at the configured positions
+func (gs *State) assignPatrols() error {
+	for _, patrol := range gs.LevelConfig.Patrols {
+		if len(patrol.Waypoints) == 0 {
+			continue
+		}
+
+		eeper := gs.eeperAt(patrol.Guard, entities.EeperGuard, entities.EeperMother)
+		if eeper == nil {
+			return fmt.Errorf("level %s: no guard at %d,%d for patrol route", gs.CurrentLevelPath, patrol.Guard.X, patrol.Guard.Y)
+		}
+		eeper.Patrol = patrol.Waypoints
+		eeper.PatrolIndex = 0
+	}
+
+	return nil
+}
+
+// patrolStep walks the eeper one cell along its patrol route, respecting its
+// footprint, skipping waypoints it cannot reach. Returns false if the eeper has
+// no route or cannot reach any of its waypoints.
+func (gs *State) patrolStep(eeper *entities.EeperState) bool {
+	if len(eeper.Patrol) == 0 {
+		return false
+	}
+
+	for range eeper.Patrol {
+		waypoint := eeper.Patrol[eeper.PatrolIndex]
+		if eeper.Position != waypoint && gs.stepEeperToward(eeper, waypoint, world.IVector2{X: 1, Y: 1}) {
+			return true
+		}
+
+		// Head for the next waypoint once the current one is reached, or when
+		// it cannot be reached (walled off, behind a closed door or gate)
+		eeper.PatrolIndex = (eeper.PatrolIndex + 1) % len(eeper.Patrol)
+	}
+
+	return false
+}
+
+// stepEeperToward moves the eeper one cell along the shortest path to a target.
+// With a 1x1 targetSize the eeper's top-left corner has to reach the target, with
+// targetSize equal to the eeper's size any overlap of its footprint with the target
+// counts. Returns false if no step brings it closer.
+func (gs *State) stepEeperToward(eeper *entities.EeperState, target world.IVector2, targetSize world.IVector2) bool {
+	canStand := func(p pathfinding.Point) bool {
+		return gs.eeperCanStandHere(world.IVector2{X: p.X, Y: p.Y}, eeper)
+	}
+
+	distances := pathfinding.ComputeDistanceMap(
+		gs.Map,
+		pathfinding.Point{X: target.X, Y: target.Y},
+		pathfinding.Point{X: targetSize.X, Y: targetSize.Y},
+		guardStepsLimit,
+		1,
+		canStand,
+	)
+
+	currentDist := distances[eeper.Position.Y][eeper.Position.X]
+	if currentDist <= 0 {
+		return false
+	}
+
+	var availablePositions []world.IVector2
+	for _, dir := range Directions {
+		newPos := eeper.Position.Add(dir)
+		if gs.WithinMap(newPos) && distances[newPos.Y][newPos.X] == currentDist-1 {
+			availablePositions = append(availablePositions, newPos)
+		}
+	}
+	if len(availablePositions) == 0 {
+		return false
+	}
+
+	eeper.Position = availablePositions[rng.Intn(len(availablePositions))]
+	return true
+}
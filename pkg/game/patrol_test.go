@@ -0,0 +1,56 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+)
+
+// walledRoomState builds a room three cells high split by a wall at x=6,
+// with a guard at 1,1 in its left half
+func walledRoomState() *State {
+	const width, height = 12, 5
+
+	gs := &State{Rules: DefaultRules()}
+	gs.Map = make([][]world.Cell, height)
+	for y := range gs.Map {
+		gs.Map[y] = make([]world.Cell, width)
+		for x := range gs.Map[y] {
+			if y == 0 || y == height-1 || x == 0 || x == width-1 || x == 6 {
+				gs.Map[y][x] = world.CellWall
+			} else {
+				gs.Map[y][x] = world.CellFloor
+			}
+		}
+	}
+
+	gs.Player.Position = world.IVector2{X: 10, Y: 2}
+	gs.SpawnGuard(world.IVector2{X: 1, Y: 1})
+	return gs
+}
+
+func TestPatrolSkipsUnreachableWaypoint(t *testing.T) {
+	gs := walledRoomState()
+	guard := &gs.Eepers[0]
+	guard.Patrol = []world.IVector2{{X: 8, Y: 1}, {X: 3, Y: 1}}
+
+	if !gs.patrolStep(guard) {
+		t.Fatalf("guard did not move towards its reachable waypoint")
+	}
+	if guard.PatrolIndex != 1 {
+		t.Errorf("guard heads for waypoint %d, want 1", guard.PatrolIndex)
+	}
+	if want := (world.IVector2{X: 2, Y: 1}); guard.Position != want {
+		t.Errorf("guard at %d,%d, want %d,%d", guard.Position.X, guard.Position.Y, want.X, want.Y)
+	}
+}
+
+func TestPatrolStopsWithoutReachableWaypoint(t *testing.T) {
+	gs := walledRoomState()
+	guard := &gs.Eepers[0]
+	guard.Patrol = []world.IVector2{{X: 8, Y: 1}, {X: 9, Y: 1}}
+
+	if gs.patrolStep(guard) {
+		t.Errorf("guard moved although every waypoint is walled off")
+	}
+}
@@ -1,14 +1,32 @@
 package game
 
 import (
-	"math/rand"
-
 	"github.com/engpetarmarinov/eepers-go/pkg/audio"
 	"github.com/engpetarmarinov/eepers-go/pkg/entities"
 	"github.com/engpetarmarinov/eepers-go/pkg/world"
 	rl "github.com/gen2brain/raylib-go/raylib"
 )
 
+// DamageSource represents what dealt damage to the player.
+type DamageSource int
+
+const (
+	DamageExplosion DamageSource = iota
+	DamageGuard
+)
+
+// damageAmount returns the health a damage source takes from the player under the active rules
+func (gs *State) damageAmount(source DamageSource) float32 {
+	switch source {
+	case DamageExplosion:
+		return gs.Rules.ExplosionPlayerDamage
+	case DamageGuard:
+		return gs.Rules.GuardPlayerDamage
+	default:
+		return 0
+	}
+}
+
 // playerDirection represents a playerDirection of movement.
 type playerDirection int
 
@@ -28,6 +46,11 @@ var playerDirectionVector = map[playerDirection]world.IVector2{
 }
 
 func (gs *State) RemoveDoor(startPos world.IVector2) {
+	// Opening doors and breaking barricades can be heard by nearby eepers
+	defer gs.EmitNoise(startPos, gs.Rules.DoorNoiseRadius)
+
+	// Only the connected cells of the same kind (and door colour) are removed
+	target := gs.Map[startPos.Y][startPos.X]
 	q := []world.IVector2{startPos}
 	visited := make(map[world.IVector2]bool)
 	visited[startPos] = true
@@ -36,15 +59,14 @@ func (gs *State) RemoveDoor(startPos world.IVector2) {
 		curr := q[0]
 		q = q[1:]
 
-		if gs.WithinMap(curr) && (gs.Map[curr.Y][curr.X] == world.CellDoor || gs.Map[curr.Y][curr.X] == world.CellBarricade) {
-			isBarricade := gs.Map[curr.Y][curr.X] == world.CellBarricade
+		if gs.WithinMap(curr) && (gs.Map[curr.Y][curr.X].IsDoor() || gs.Map[curr.Y][curr.X] == world.CellBarricade) {
 			gs.Map[curr.Y][curr.X] = world.CellFloor
 
 			// Cardinal directions
 			for _, dir := range playerDirectionVector {
 				next := curr.Add(dir)
 				if _, found := visited[next]; !found {
-					if gs.WithinMap(next) && ((isBarricade && gs.Map[next.Y][next.X] == world.CellBarricade) || (!isBarricade && gs.Map[next.Y][next.X] == world.CellDoor)) {
+					if gs.WithinMap(next) && gs.Map[next.Y][next.X] == target {
 						q = append(q, next)
 						visited[next] = true
 					}
@@ -59,7 +81,7 @@ func (gs *State) RemoveDoor(startPos world.IVector2) {
 			} {
 				next := curr.Add(diag)
 				if _, found := visited[next]; !found {
-					if gs.WithinMap(next) && ((isBarricade && gs.Map[next.Y][next.X] == world.CellBarricade) || (!isBarricade && gs.Map[next.Y][next.X] == world.CellDoor)) {
+					if gs.WithinMap(next) && gs.Map[next.Y][next.X] == target {
 						q = append(q, next)
 						visited[next] = true
 					}
@@ -69,9 +91,26 @@ func (gs *State) RemoveDoor(startPos world.IVector2) {
 	}
 }
 
+// tickPlayerTimers counts down the player's invulnerability and immunity by one turn
+func (gs *State) tickPlayerTimers() {
+	if gs.Player.InvulnerableTurns > 0 {
+		gs.Player.InvulnerableTurns--
+	}
+	if gs.Player.Modifiers.ImmunityTurns > 0 {
+		gs.Player.Modifiers.ImmunityTurns--
+	}
+}
+
 // PlayerTurn handles the player's turn.
 func (gs *State) PlayerTurn(dir playerDirection) {
 	gs.Player.PrevPosition = gs.Player.Position
+	for i := range gs.Blocks {
+		gs.Blocks[i].PrevPosition = gs.Blocks[i].Position
+	}
+	if !gs.RealTimeEnabled() {
+		gs.tickPlayerTimers()
+	}
+	gs.Player.Moves = append(gs.Player.Moves, playerDirectionVector[dir])
 	newPos := gs.Player.Position.Add(playerDirectionVector[dir])
 
 	// Set eyes target to look in the playerDirection of movement
@@ -81,16 +120,31 @@ func (gs *State) PlayerTurn(dir playerDirection) {
 		return
 	}
 
-	switch gs.Map[newPos.Y][newPos.X] {
-	case world.CellFloor:
+	switch cell := gs.Map[newPos.Y][newPos.X]; {
+	case cell.IsWalkable():
+		// Kick a planted bomb ahead instead of stepping onto it
+		if gs.Player.Modifiers.Kick && gs.kickBomb(newPos, playerDirectionVector[dir]) {
+			rl.PlaySound(audio.PlantBombSound)
+			return
+		}
+
+		// Slide over ice until something stops the player
+		newPos = gs.slideOnIce(newPos, playerDirectionVector[dir], gs.playerCanSlideInto)
 		gs.Player.Position = newPos
-		rl.PlaySound(audio.FootstepsSounds[rand.Intn(len(audio.FootstepsSounds))])
+		if gs.Map[newPos.Y][newPos.X] == world.CellPit {
+			gs.KillPlayer() // Slid into a pit
+			return
+		}
+		rl.PlaySound(audio.FootstepsSounds[rng.Intn(len(audio.FootstepsSounds))])
+		if gs.Player.Sprinting {
+			gs.EmitNoise(newPos, gs.Rules.SprintNoiseRadius)
+		}
 		for i := range gs.Items {
 			item := &gs.Items[i]
 			if item.Position == newPos {
 				switch item.Kind {
 				case entities.ItemKey:
-					gs.Player.Keys++
+					gs.Player.Keys[item.Color]++
 					item.Kind = entities.ItemNone // Mark as collected
 					rl.PlaySound(audio.KeyPickupSound)
 				case entities.ItemBombRefill:
@@ -101,12 +155,31 @@ func (gs *State) PlayerTurn(dir playerDirection) {
 							gs.Tutorial.Phase = TutorialPlaceBombs
 						}
 						gs.Player.Bombs++
-						item.Cooldown = 10 // BOMB_GENERATOR_COOLDOWN
+						item.Cooldown = gs.Rules.BombRefillCooldown
 						rl.PlaySound(audio.BombPickupSound)
 					}
 				case entities.ItemBombSlot:
 					gs.Player.BombSlots++
 					item.Kind = entities.ItemNone // Mark as collected
+				case entities.ItemBombRemote, entities.ItemBombLongFuse, entities.ItemBombCross:
+					kind, _ := item.Kind.SpecialBombKind()
+					gs.Player.SpecialBombs[kind]++
+					item.Kind = entities.ItemNone // Mark as collected
+					rl.PlaySound(audio.BombPickupSound)
+				case entities.ItemHeal:
+					// Only pick up if the player is hurt
+					if gs.Player.Health < 1.0 {
+						gs.Player.Health += gs.Rules.HealAmount
+						if gs.Player.Health > 1.0 {
+							gs.Player.Health = 1.0
+						}
+						item.Kind = entities.ItemNone // Mark as collected
+						rl.PlaySound(audio.CheckpointSound)
+					}
+				case entities.ItemPowerBlastRange, entities.ItemPowerShortFuse, entities.ItemPowerKick, entities.ItemPowerImmunity:
+					gs.collectPowerUp(item.Kind)
+					item.Kind = entities.ItemNone // Mark as collected
+					rl.PlaySound(audio.BombPickupSound)
 				case entities.ItemCheckpoint:
 					// Mark as collected first, then save state
 					item.Kind = entities.ItemNone
@@ -116,6 +189,9 @@ func (gs *State) PlayerTurn(dir playerDirection) {
 			}
 		}
 
+		// Check if player stepped on a teleporter pad
+		gs.teleportPlayer()
+
 		// Check if player stepped on a portal
 		portal := gs.GetPortalAtPosition(newPos)
 		if portal != nil && portal.OpenProgress > 0.8 {
@@ -125,14 +201,26 @@ func (gs *State) PlayerTurn(dir playerDirection) {
 			gs.Player.PortalEntryTime = rl.GetTime()
 			gs.Player.PortalToActivate = portal.ID
 		}
-	case world.CellDoor:
-		if gs.Player.Keys > 0 {
-			gs.Player.Keys--
+	case cell.IsDoor():
+		// Only keys of the door's colour open it
+		color := cell.DoorColor()
+		if gs.Player.Keys[color] > 0 {
+			gs.Player.Keys[color]--
 			gs.RemoveDoor(newPos)
 			gs.Player.Position = newPos
 			rl.PlaySound(audio.OpenDoorSound)
 		}
-	case world.CellBarricade:
+	case cell == world.CellBlock:
+		// Shove the block ahead if there is room behind it
+		if gs.pushBlock(newPos, playerDirectionVector[dir]) {
+			gs.Player.Position = newPos
+			rl.PlaySound(audio.FootstepsSounds[rng.Intn(len(audio.FootstepsSounds))])
+		}
+	case cell == world.CellPit:
+		// Walking into a pit is deadly
+		gs.Player.Position = newPos
+		gs.KillPlayer()
+	case cell == world.CellBarricade:
 		// Player cannot move through barricades
 	}
 }
@@ -142,8 +230,37 @@ func (gs *State) WithinMap(pos world.IVector2) bool {
 	return pos.Y >= 0 && pos.Y < len(gs.Map) && pos.X >= 0 && pos.X < len(gs.Map[0])
 }
 
+// DamagePlayer deals damage from a source to the player, killing them when
+// their health runs out (or immediately on one-hit-death levels)
+func (gs *State) DamagePlayer(source DamageSource) {
+	if gs.GodMode || gs.Player.Dead || gs.Player.InvulnerableTurns > 0 {
+		return
+	}
+	if source == DamageExplosion && gs.Player.Modifiers.ImmunityTurns > 0 {
+		return
+	}
+
+	if gs.LevelConfig.OneHitDeath {
+		gs.KillPlayer()
+		return
+	}
+
+	gs.Player.Health -= gs.damageAmount(source)
+	if gs.Player.Health <= 0 {
+		gs.KillPlayer()
+		return
+	}
+
+	// Survived the hit - grant brief invulnerability
+	rl.PlaySound(audio.HurtSound)
+	gs.Player.InvulnerableTurns = gs.Rules.PlayerInvulnerabilityTurns
+}
+
 // KillPlayer marks the player as dead and records the time of death.
 func (gs *State) KillPlayer() {
+	if gs.GodMode {
+		return
+	}
 	if !gs.Player.Dead {
 		rl.PlaySound(audio.HurtSound)
 		gs.Player.Health = 0
@@ -0,0 +1,50 @@
+package game
+
+import (
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+)
+
+// collectPowerUp applies the power-up item to the player's modifiers
+func (gs *State) collectPowerUp(kind entities.ItemKind) {
+	modifiers := &gs.Player.Modifiers
+	switch kind {
+	case entities.ItemPowerBlastRange:
+		if modifiers.BlastRange < gs.Rules.MaxBlastRangeBonus {
+			modifiers.BlastRange++
+		}
+	case entities.ItemPowerShortFuse:
+		// Bombs always leave time to get out of the blast, see PlantBomb
+		if modifiers.FuseReduction < gs.Rules.MaxFuseReduction {
+			modifiers.FuseReduction++
+		}
+	case entities.ItemPowerKick:
+		modifiers.Kick = true
+	case entities.ItemPowerImmunity:
+		modifiers.ImmunityTurns = gs.Rules.ImmunityTurns
+	}
+}
+
+// kickBomb slides the bomb planted at position in the direction until it hits
+// an obstacle. Returns false if there is no bomb or it cannot move at all.
+func (gs *State) kickBomb(position world.IVector2, dir world.IVector2) bool {
+	i := gs.findBomb(func(bomb entities.BombState) bool { return bomb.Position == position })
+	if i < 0 {
+		return false
+	}
+
+	target := position
+	for {
+		next := target.Add(dir)
+		if !gs.WithinMap(next) || !gs.Map[next.Y][next.X].IsWalkable() || gs.isCellOccupied(next) {
+			break
+		}
+		target = next
+	}
+	if target == position {
+		return false
+	}
+
+	gs.Bombs[i].Position = target
+	return true
+}
@@ -0,0 +1,15 @@
+package game
+
+import (
+	"math/rand"
+	"time"
+)
+
+// rng is the random source used by all gameplay decisions so runs can be
+// reproduced by seeding it (e.g. from the developer console)
+var rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+// SeedRandom reseeds the gameplay random number generator
+func SeedRandom(seed int64) {
+	rng.Seed(seed)
+}
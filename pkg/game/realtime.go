@@ -0,0 +1,73 @@
+package game
+
+import "github.com/engpetarmarinov/eepers-go/pkg/entities"
+
+// RealTimeEnabled reports whether the world advances on a timer instead of
+// with the player's moves, either for the current level or as a challenge mode
+func (gs *State) RealTimeEnabled() bool {
+	return gs.Settings.RealTime || gs.LevelConfig.RealTime
+}
+
+// WorldTurn advances everything but the player by one turn
+func (gs *State) WorldTurn() {
+	gs.ItemsTurn()
+	gs.UpdateEepers()
+	gs.TerrainTurn()
+	gs.UpdateBombs()
+
+	// Every move is copied by mirrors only once
+	gs.Player.Moves = nil
+}
+
+// UpdateRealTime advances the world by RealTimeTurnsPerSecond turns per second
+// in real-time mode. The clock stands still while the player is dying,
+// leaving the level or celebrating.
+func (gs *State) UpdateRealTime(frameTime float32) {
+	if !gs.RealTimeEnabled() || gs.Rules.RealTimeTurnsPerSecond <= 0 {
+		gs.RealTimeClock = 0
+		return
+	}
+	if gs.Player.Dead || gs.Player.EnteringPortal || gs.Player.ReachedFather {
+		return
+	}
+
+	gs.RealTimeClock += frameTime * gs.Rules.RealTimeTurnsPerSecond
+	for gs.RealTimeClock >= 1 {
+		gs.RealTimeClock--
+
+		// The player's timers run with the world, not with their moves
+		gs.tickPlayerTimers()
+
+		// The world animates separately so it does not hold back the player's moves
+		playerPosition := gs.Player.Position
+		gs.WorldAnimation = 1.0
+
+		gs.WorldTurn()
+
+		// Conveyors carry the player along, animated like a move unless the
+		// player's own move is still animating and simply ends further on
+		if gs.Player.Position != playerPosition && gs.TurnAnimation <= 0 {
+			gs.Player.PrevPosition = playerPosition
+			gs.TurnAnimation = 1.0
+		}
+	}
+}
+
+// EeperAnimation returns how far the eepers still have to go in their move
+// animation, 1 at the start and 0 once they arrived. Eepers move with the
+// player's turns, or with the world's turns in real-time mode.
+func (gs *State) EeperAnimation() float32 {
+	if gs.RealTimeEnabled() {
+		return gs.WorldAnimation
+	}
+	return gs.TurnAnimation
+}
+
+// BombTimeLeft returns the turns left until the bomb explodes. In real-time
+// mode it includes the part of the current turn that has already passed.
+func (gs *State) BombTimeLeft(bomb entities.BombState) float32 {
+	if !gs.RealTimeEnabled() {
+		return float32(bomb.Countdown)
+	}
+	return float32(bomb.Countdown) - gs.RealTimeClock
+}
@@ -0,0 +1,174 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Difficulty represents a rules preset selectable in the settings.
+type Difficulty int
+
+const (
+	DifficultyEasy Difficulty = iota
+	DifficultyNormal
+	DifficultyHard
+	difficultyCount
+)
+
+// String returns the name of the difficulty as used in the rules file and menu.
+func (d Difficulty) String() string {
+	switch d {
+	case DifficultyEasy:
+		return "Easy"
+	case DifficultyNormal:
+		return "Normal"
+	case DifficultyHard:
+		return "Hard"
+	default:
+		return "Unknown"
+	}
+}
+
+// Rules holds the tunable balance values of the game. Keys in the rules file
+// and in level configs use the Go field names.
+type Rules struct {
+	GuardAttackCooldown        int     // Turns a guard waits between moves
+	GuardTurnRegeneration      float32 // Health a guard regenerates each turn
+	GnomeStepsLimit            int     // How many steps away a gnome notices the player
+	FatherWakeUpRadius         int     // Cells around the Father in which he wakes up
+	BombCountdown              int     // Turns until a planted bomb explodes
+	LongFuseCountdown          int     // Turns until a planted long-fuse bomb explodes
+	ExplosionDamage            float32 // Health an explosion takes from a guard
+	StunTurns                  int     // Turns an eeper caught by the edge of an explosion is stunned
+	SlowTurns                  int     // Turns an eeper acts only every other turn after coming round from a stun
+	EnrageTurns                int     // Turns a wounded guard or bomber ignores its cooldown
+	ExplosionRadius            int     // Cells an explosion reaches in each direction
+	BombRefillCooldown         int     // Turns until a bomb refill can be picked up again
+	ExplosionPlayerDamage      float32 // Health an explosion takes from the player
+	GuardPlayerDamage          float32 // Health a guard attack takes from the player
+	PlayerInvulnerabilityTurns int     // Turns the player is invulnerable after being hit
+	HealAmount                 float32 // Health restored by a heal item
+	ImmunityTurns              int     // Turns of explosion immunity given by the immunity power-up
+	MaxBlastRangeBonus         int     // Cap on the extra range from blast range power-ups
+	MaxFuseReduction           int     // Cap on the turns short fuse power-ups take off a bomb's countdown
+	GuardVisionRange           int     // Cells a guard can see (half while sleeping)
+	GuardVisionAngle           float32 // Half-angle of a guard's view cone in degrees
+	AlertGain                  float32 // Alert a guard gains per turn seeing the player (doubled when close)
+	AlertDecay                 float32 // Alert a guard loses per turn not seeing the player
+	GuardSearchTurns           int     // Turns a guard looks around where it lost the player before heading back
+	BarricadeBreakTurns        int     // Turns a barricade-breaking guard needs to smash a barricade
+	SprintNoiseRadius          int     // Cells the noise of a sprinting step travels
+	DoorNoiseRadius            int     // Cells the noise of an opening door travels
+	BlastNoiseRadius           int     // Cells the noise of a bomb blast travels
+	TeleporterCooldown         int     // Turns a teleporter pad pair rests after being used
+	GuardsUseTeleporters       bool    // Guards and mothers path through and use teleporter pads
+	GnomesUseTeleporters       bool    // Gnomes use teleporter pads they step on
+	BossExplosionDamage        float32 // Health an explosion takes from an unshielded boss
+	BomberKeepDistance         int     // Steps a bomber tries to keep between itself and the player
+	BomberThrowCooldown        int     // Turns a bomber waits between throwing bombs
+	RealTimeTurnsPerSecond     float32 // World turns per second in real-time mode
+}
+
+// DefaultRules returns the Normal difficulty rules used when no rules file overrides them
+func DefaultRules() Rules {
+	return Rules{
+		GuardAttackCooldown:        10,
+		GuardTurnRegeneration:      0.01,
+		GnomeStepsLimit:            9,
+		FatherWakeUpRadius:         3,
+		BombCountdown:              3,
+		LongFuseCountdown:          8,
+		ExplosionDamage:            0.45,
+		StunTurns:                  3,
+		SlowTurns:                  4,
+		EnrageTurns:                2,
+		ExplosionRadius:            4,
+		BombRefillCooldown:         10,
+		ExplosionPlayerDamage:      0.5,
+		GuardPlayerDamage:          0.34,
+		PlayerInvulnerabilityTurns: 2,
+		HealAmount:                 0.5,
+		ImmunityTurns:              10,
+		MaxBlastRangeBonus:         4,
+		MaxFuseReduction:           3,
+		GuardVisionRange:           10,
+		GuardVisionAngle:           50,
+		AlertGain:                  0.35,
+		AlertDecay:                 0.1,
+		GuardSearchTurns:           5,
+		BarricadeBreakTurns:        4,
+		SprintNoiseRadius:          6,
+		DoorNoiseRadius:            8,
+		BlastNoiseRadius:           14,
+		TeleporterCooldown:         3,
+		GuardsUseTeleporters:       true,
+		GnomesUseTeleporters:       true,
+		BossExplosionDamage:        0.15,
+		BomberKeepDistance:         5,
+		BomberThrowCooldown:        6,
+		RealTimeTurnsPerSecond:     2,
+	}
+}
+
+// rulePresets holds the rules for each difficulty, loaded by LoadRules
+var rulePresets = [difficultyCount]Rules{
+	DifficultyEasy:   DefaultRules(),
+	DifficultyNormal: DefaultRules(),
+	DifficultyHard:   DefaultRules(),
+}
+
+// LoadRules loads the difficulty presets from a JSON file mapping difficulty
+// names to rules. Missing presets and fields keep their default values.
+func LoadRules(filePath string) error {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("could not load rules from file %s: %w", filePath, err)
+	}
+
+	var presets map[string]json.RawMessage
+	if err := json.Unmarshal(data, &presets); err != nil {
+		return fmt.Errorf("could not parse rules file %s: %w", filePath, err)
+	}
+
+	for d := Difficulty(0); d < difficultyCount; d++ {
+		rules := DefaultRules()
+		if raw, found := presets[d.String()]; found {
+			if err := json.Unmarshal(raw, &rules); err != nil {
+				return fmt.Errorf("could not parse %s rules in %s: %w", d, filePath, err)
+			}
+		}
+		rulePresets[d] = rules
+	}
+
+	return nil
+}
+
+// applyRules computes the active rules from the selected difficulty preset and
+// the overrides of the current level
+func (gs *State) applyRules() error {
+	rules, err := gs.levelRules(gs.LevelConfig, gs.CurrentLevelPath)
+	if err != nil {
+		return err
+	}
+	gs.Rules = rules
+	return nil
+}
+
+// levelRules returns the rules of the current difficulty with the overrides
+// of the level at levelPath
+func (gs *State) levelRules(levelConfig LevelConfig, levelPath string) (Rules, error) {
+	rules := rulePresets[gs.Settings.Difficulty]
+	if len(levelConfig.Rules) > 0 {
+		if err := json.Unmarshal(levelConfig.Rules, &rules); err != nil {
+			return rules, fmt.Errorf("could not parse rules of level %s: %w", levelPath, err)
+		}
+	}
+	return rules, nil
+}
+
+// CycleDifficulty switches to the next difficulty preset and applies it immediately
+func (gs *State) CycleDifficulty() error {
+	gs.Settings.Difficulty = (gs.Settings.Difficulty + 1) % difficultyCount
+	return gs.applyRules()
+}
@@ -0,0 +1,65 @@
+package game
+
+import (
+	"github.com/engpetarmarinov/eepers-go/pkg/audio"
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// searchGlances are the directions a searching eeper looks in, one per turn
+var searchGlances = [4]world.IVector2{
+	{X: -1, Y: 0}, // Left
+	{X: 0, Y: -1}, // Up
+	{X: 1, Y: 0},  // Right
+	{X: 0, Y: 1},  // Down
+}
+
+// searchForPlayer handles an eeper that lost track of the player: it walks to
+// where the player was last seen, looks around there for a few turns and then
+// walks back to its post
+func (gs *State) searchForPlayer(eeper *entities.EeperState, turn *EeperTurn) {
+	switch eeper.Behaviour {
+	case entities.BehaviourInvestigate:
+		if gs.stepEeperToward(eeper, eeper.LastKnownPosition, eeper.Size) {
+			rl.PlaySound(audio.GuardStepSound)
+		} else {
+			// Arrived (or cannot get any closer) - look around for the player
+			eeper.Behaviour = entities.BehaviourSearch
+			eeper.SearchTurns = gs.Rules.GuardSearchTurns
+		}
+		eeper.Eyes = entities.EyesOpen
+		eeper.EyesTarget = eeper.LastKnownPosition
+	case entities.BehaviourSearch:
+		// Glance in a different direction every turn
+		glance := searchGlances[eeper.SearchTurns%len(searchGlances)]
+		center := eeper.Position.Add(world.IVector2{X: eeper.Size.X / 2, Y: eeper.Size.Y / 2})
+		eeper.EyesTarget = center.Add(glance.Mul(eeper.Size.X + 2))
+		eeper.Eyes = entities.EyesSearching
+
+		eeper.SearchTurns--
+		if eeper.SearchTurns <= 0 {
+			eeper.Behaviour = entities.BehaviourReturn
+		}
+	case entities.BehaviourReturn:
+		// Patrolling eepers simply resume their route from where they are
+		if len(eeper.Patrol) == 0 && gs.stepEeperToward(eeper, eeper.Post, world.IVector2{X: 1, Y: 1}) {
+			rl.PlaySound(audio.GuardStepSound)
+			// Look where we're walking
+			eeper.EyesTarget = eeper.Position.Add(eeper.Position.Sub(turn.OldPosition).Mul(eeper.Size.X))
+			eeper.Eyes = entities.EyesOpen
+		} else {
+			// Back at the post (or unable to get there) - back to resting
+			gs.restEeper(eeper, turn)
+			return
+		}
+	}
+
+	// Noticing the player - stare at them while the alert meter fills up
+	if turn.SeesPlayer {
+		eeper.Eyes = entities.EyesSurprised
+		eeper.EyesTarget = gs.Player.Position
+	}
+
+	eeper.AttackCooldown = gs.Rules.GuardAttackCooldown + 1
+}
@@ -0,0 +1,15 @@
+package game
+
+// Settings holds the player-selectable options that persist across levels.
+type Settings struct {
+	Difficulty      Difficulty // Rules preset used for every level
+	ShowVisionCones bool       // Accessibility: draw the view cones of guards
+	RealTime        bool       // Challenge mode: every level advances on a timer
+}
+
+// DefaultSettings returns the settings used for a new game
+func DefaultSettings() Settings {
+	return Settings{
+		Difficulty: DifficultyNormal,
+	}
+}
@@ -0,0 +1,282 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+)
+
+// SnapshotVersion is the current version of the snapshot JSON schema.
+// Bump it whenever a change to the schema would make old snapshots load incorrectly.
+const SnapshotVersion = 5
+
+// DefaultSnapshotPath is where snapshots are saved and loaded by the debug hotkeys
+const DefaultSnapshotPath = "snapshot.json"
+
+// Snapshot is the versioned JSON representation of a game state, used to attach
+// exact reproductions to bug reports and to diff states between builds.
+//
+// Schema (version 5), all keys use the Go field names:
+//
+//	Version       int      - schema version, must equal SnapshotVersion
+//	World         int      - index of the current world in the world config
+//	LevelPath     string   - path of the loaded level image
+//	InHub         bool     - whether the level is the world's hub
+//	Map           []string - one string per map row, one character per cell (see snapshotCellRunes)
+//	Player        object   - entities.PlayerState
+//	Eepers        []object - entities.EeperState; Path is null when distance maps are omitted
+//	Items         []object - entities.Item
+//	Bombs         []object - entities.BombState
+//	Explosions    []object - entities.ExplosionState
+//	Portals       []object - entities.PortalState
+//	Wires         []object - entities.WireState
+//	Blocks        []object - entities.BlockState
+//	Teleporters   []object - entities.TeleporterState
+//	Tutorial      object   - TutorialState
+type Snapshot struct {
+	Version     int
+	World       int
+	LevelPath   string
+	InHub       bool
+	Map         []string
+	Player      entities.PlayerState
+	Eepers      []entities.EeperState
+	Items       []entities.Item
+	Bombs       []entities.BombState
+	Explosions  []entities.ExplosionState
+	Portals     []entities.PortalState
+	Wires       []entities.WireState
+	Blocks      []entities.BlockState
+	Teleporters []entities.TeleporterState
+	Tutorial    TutorialState
+}
+
+// snapshotCellRunes maps each map cell to the character used in snapshot map rows
+var snapshotCellRunes = map[world.Cell]rune{
+	world.CellNone:          ' ',
+	world.CellFloor:         '.',
+	world.CellWall:          '#',
+	world.CellDoor:          'D',
+	world.CellBarricade:     'B',
+	world.CellExplosion:     '*',
+	world.CellDoorRed:       'R',
+	world.CellDoorGreen:     'G',
+	world.CellDoorYellow:    'Y',
+	world.CellPlate:         '_',
+	world.CellLever:         '/',
+	world.CellGateClosed:    '=',
+	world.CellGateOpen:      '-',
+	world.CellBlock:         'O',
+	world.CellIce:           '~',
+	world.CellConveyorUp:    '^',
+	world.CellConveyorDown:  'v',
+	world.CellConveyorLeft:  '<',
+	world.CellConveyorRight: '>',
+	world.CellPit:           'U',
+	world.CellBridge:        '+',
+	world.CellWeakPoint:     'X',
+}
+
+// ExportSnapshot serializes the current game state to snapshot JSON.
+// Eeper distance maps are only included when includePaths is true.
+func (gs *State) ExportSnapshot(includePaths bool) ([]byte, error) {
+	snapshot := Snapshot{
+		Version:     SnapshotVersion,
+		World:       gs.WorldConfig.CurrentWorld,
+		LevelPath:   gs.CurrentLevelPath,
+		InHub:       gs.InHub,
+		Map:         make([]string, len(gs.Map)),
+		Player:      gs.Player,
+		Eepers:      make([]entities.EeperState, len(gs.Eepers)),
+		Items:       gs.Items,
+		Bombs:       gs.Bombs,
+		Explosions:  gs.Explosions,
+		Portals:     gs.Portals,
+		Wires:       gs.Wires,
+		Blocks:      gs.Blocks,
+		Teleporters: gs.Teleporters,
+		Tutorial:    gs.Tutorial,
+	}
+
+	for y, row := range gs.Map {
+		runes := make([]rune, len(row))
+		for x, cell := range row {
+			r, found := snapshotCellRunes[cell]
+			if !found {
+				return nil, fmt.Errorf("cell %d at %d,%d has no snapshot character", cell, x, y)
+			}
+			runes[x] = r
+		}
+		snapshot.Map[y] = string(runes)
+	}
+
+	copy(snapshot.Eepers, gs.Eepers)
+	if !includePaths {
+		for i := range snapshot.Eepers {
+			snapshot.Eepers[i].Path = nil
+		}
+	}
+
+	return json.MarshalIndent(snapshot, "", "  ")
+}
+
+// ImportSnapshot replaces the current game state with the one in snapshot JSON.
+// The imported state also becomes the checkpoint the player respawns at.
+func (gs *State) ImportSnapshot(data []byte) error {
+	var snapshot Snapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return fmt.Errorf("could not parse snapshot: %w", err)
+	}
+	if snapshot.Version != SnapshotVersion {
+		return fmt.Errorf("unsupported snapshot version %d (expected %d)", snapshot.Version, SnapshotVersion)
+	}
+	if len(snapshot.Map) == 0 {
+		return fmt.Errorf("snapshot has an empty map")
+	}
+
+	// Per-level settings are static level data, so they are reloaded instead of stored
+	levelConfig, err := LoadLevelConfig(snapshot.LevelPath)
+	if err != nil {
+		return err
+	}
+	rules, err := gs.levelRules(levelConfig, snapshot.LevelPath)
+	if err != nil {
+		return err
+	}
+
+	// Decode the map before touching the state so a bad snapshot leaves it intact
+	cellsByRune := make(map[rune]world.Cell, len(snapshotCellRunes))
+	for cell, r := range snapshotCellRunes {
+		cellsByRune[r] = cell
+	}
+	width := len([]rune(snapshot.Map[0]))
+	gameMap := make([][]world.Cell, len(snapshot.Map))
+	for y, row := range snapshot.Map {
+		runes := []rune(row)
+		if len(runes) != width {
+			return fmt.Errorf("map row %d has width %d (expected %d)", y, len(runes), width)
+		}
+		gameMap[y] = make([]world.Cell, width)
+		for x, r := range runes {
+			cell, found := cellsByRune[r]
+			if !found {
+				return fmt.Errorf("unknown map character %q at %d,%d", r, x, y)
+			}
+			gameMap[y][x] = cell
+		}
+	}
+
+	// Positions are used to index the map every turn, so they have to be on it
+	withinMap := func(pos world.IVector2) bool {
+		return pos.X >= 0 && pos.X < width && pos.Y >= 0 && pos.Y < len(gameMap)
+	}
+	if !withinMap(snapshot.Player.Position) {
+		return fmt.Errorf("player at %d,%d is outside the map", snapshot.Player.Position.X, snapshot.Player.Position.Y)
+	}
+	for i, eeper := range snapshot.Eepers {
+		if eeper.Dead {
+			continue
+		}
+		last := eeper.Position.Add(eeper.Size).Sub(world.IVector2{X: 1, Y: 1})
+		if eeper.Size.X < 1 || eeper.Size.Y < 1 || !withinMap(eeper.Position) || !withinMap(last) {
+			return fmt.Errorf("eeper #%d of size %dx%d at %d,%d does not fit on the map", i, eeper.Size.X, eeper.Size.Y, eeper.Position.X, eeper.Position.Y)
+		}
+		if eeper.Path != nil && (len(eeper.Path) != len(gameMap) || len(eeper.Path[0]) != width) {
+			return fmt.Errorf("eeper #%d has a distance map of a different size than the map", i)
+		}
+	}
+	checkPositions := func(what string, i int, positions ...world.IVector2) error {
+		for _, pos := range positions {
+			if !withinMap(pos) {
+				return fmt.Errorf("%s #%d at %d,%d is outside the map", what, i, pos.X, pos.Y)
+			}
+		}
+		return nil
+	}
+	for i, bomb := range snapshot.Bombs {
+		if err := checkPositions("bomb", i, bomb.Position); err != nil {
+			return err
+		}
+	}
+	for i, item := range snapshot.Items {
+		if err := checkPositions("item", i, item.Position); err != nil {
+			return err
+		}
+	}
+	for i, explosion := range snapshot.Explosions {
+		if err := checkPositions("explosion", i, explosion.Position); err != nil {
+			return err
+		}
+	}
+	for i, block := range snapshot.Blocks {
+		if err := checkPositions("block", i, block.Position); err != nil {
+			return err
+		}
+	}
+	for i, portal := range snapshot.Portals {
+		if err := checkPositions("portal", i, portal.Cells...); err != nil {
+			return err
+		}
+	}
+	for i, wire := range snapshot.Wires {
+		if err := checkPositions("wire", i, append(append([]world.IVector2(nil), wire.Triggers...), wire.Gates...)...); err != nil {
+			return err
+		}
+		if len(wire.Pressed) != len(wire.Triggers) || len(wire.GatesOpen) != len(wire.Gates) {
+			return fmt.Errorf("wire #%d has %d triggers and %d gates, but state for %d and %d", i, len(wire.Triggers), len(wire.Gates), len(wire.Pressed), len(wire.GatesOpen))
+		}
+	}
+	for i, teleporter := range snapshot.Teleporters {
+		if err := checkPositions("teleporter", i, teleporter.Position); err != nil {
+			return err
+		}
+		if teleporter.Link < 0 || teleporter.Link >= len(snapshot.Teleporters) || teleporter.Link == i {
+			return fmt.Errorf("teleporter #%d links to #%d instead of another pad", i, teleporter.Link)
+		}
+	}
+
+	gs.Map = gameMap
+	gs.Rules = rules
+	gs.WorldConfig.CurrentWorld = snapshot.World
+	gs.CurrentLevelPath = snapshot.LevelPath
+	gs.LevelConfig = levelConfig
+	gs.InHub = snapshot.InHub
+	gs.Player = snapshot.Player
+	gs.Player.PrevPosition = gs.Player.Position // Avoid interpolating from a stale position
+	gs.Eepers = snapshot.Eepers
+	gs.Items = snapshot.Items
+	gs.Bombs = snapshot.Bombs
+	gs.Explosions = snapshot.Explosions
+	gs.Portals = snapshot.Portals
+	gs.Wires = snapshot.Wires
+	gs.Blocks = snapshot.Blocks
+	gs.Teleporters = snapshot.Teleporters
+	gs.Tutorial = snapshot.Tutorial
+	gs.TurnAnimation = 0
+	gs.WorldAnimation = 0
+
+	gs.SaveCheckpoint()
+
+	return nil
+}
+
+// SaveSnapshot writes the current game state to a snapshot file
+func (gs *State) SaveSnapshot(path string, includePaths bool) error {
+	data, err := gs.ExportSnapshot(includePaths)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// LoadSnapshot replaces the current game state with the one in a snapshot file
+func (gs *State) LoadSnapshot(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("could not load snapshot from file %s: %w", path, err)
+	}
+	return gs.ImportSnapshot(data)
+}
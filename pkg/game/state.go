@@ -14,8 +14,14 @@ type State struct {
 	Eepers             []entities.EeperState
 	Bombs              []entities.BombState
 	Explosions         []entities.ExplosionState
+	Noises             []entities.NoiseState
 	Portals            []entities.PortalState
+	Wires              []entities.WireState
+	Blocks             []entities.BlockState
+	Teleporters        []entities.TeleporterState
 	TurnAnimation      float32
+	RealTimeClock      float32 // Fraction of the next world turn that has passed in real-time mode
+	WorldAnimation     float32 // Move animation of the last world turn in real-time mode, like TurnAnimation
 	Camera             rl.Camera2D
 	Tutorial           TutorialState
 	Menu               MenuState
@@ -25,18 +31,28 @@ type State struct {
 	WorldConfig        WorldConfig // Configuration for all worlds and levels
 	InHub              bool        // Whether player is currently in a hub level
 	CurrentLevelPath   string      // Path to the currently loaded level
+	LevelConfig        LevelConfig // Per-level settings of the currently loaded level
+	Settings           Settings    // Player-selectable options (difficulty, ...)
+	Rules              Rules       // Active balance values (difficulty preset + level overrides)
+	Debug              DebugState  // Developer debug overlay state
+	GodMode            bool        // Player cannot die (developer console)
 }
 
 // CheckpointState stores a snapshot of the game state for respawning
 type CheckpointState struct {
-	Map             [][]world.Cell
-	PlayerPosition  world.IVector2
-	PlayerKeys      int
-	PlayerBombs     int
-	PlayerBombSlots int
-	Eepers          []entities.EeperState
-	Items           []entities.Item
-	Bombs           []entities.BombState
+	Map                [][]world.Cell
+	PlayerPosition     world.IVector2
+	PlayerKeys         [world.KeyColorCount]int
+	PlayerBombs        int
+	PlayerBombSlots    int
+	PlayerSpecialBombs [entities.BombKindCount]int
+	PlayerModifiers    entities.PlayerModifiers
+	Eepers             []entities.EeperState
+	Items              []entities.Item
+	Bombs              []entities.BombState
+	Wires              []entities.WireState
+	Blocks             []entities.BlockState
+	Teleporters        []entities.TeleporterState
 }
 
 // AllocateItem adds a new item to the game state at the specified position.
@@ -48,6 +64,15 @@ func (gs *State) AllocateItem(position world.IVector2, kind entities.ItemKind) {
 	})
 }
 
+// AllocateKey adds a key of the given colour to the game state.
+func (gs *State) AllocateKey(position world.IVector2, color world.KeyColor) {
+	gs.Items = append(gs.Items, entities.Item{
+		Position: position,
+		Kind:     entities.ItemKey,
+		Color:    color,
+	})
+}
+
 // SaveCheckpoint saves the current game state to checkpoint
 func (gs *State) SaveCheckpoint() {
 	// Clone the map
@@ -62,11 +87,14 @@ func (gs *State) SaveCheckpoint() {
 	gs.Checkpoint.PlayerKeys = gs.Player.Keys
 	gs.Checkpoint.PlayerBombs = gs.Player.Bombs
 	gs.Checkpoint.PlayerBombSlots = gs.Player.BombSlots
+	gs.Checkpoint.PlayerSpecialBombs = gs.Player.SpecialBombs
+	gs.Checkpoint.PlayerModifiers = gs.Player.Modifiers
 
 	// Clone eepers
 	gs.Checkpoint.Eepers = make([]entities.EeperState, len(gs.Eepers))
 	for i := range gs.Eepers {
 		gs.Checkpoint.Eepers[i] = gs.Eepers[i]
+		gs.Checkpoint.Eepers[i].Loot = append([]entities.Item(nil), gs.Eepers[i].Loot...)
 		// Deep copy the path map
 		if gs.Eepers[i].Path != nil {
 			gs.Checkpoint.Eepers[i].Path = make([][]int, len(gs.Eepers[i].Path))
@@ -84,6 +112,17 @@ func (gs *State) SaveCheckpoint() {
 	// Clone bombs
 	gs.Checkpoint.Bombs = make([]entities.BombState, len(gs.Bombs))
 	copy(gs.Checkpoint.Bombs, gs.Bombs)
+
+	// Clone wiring state
+	gs.Checkpoint.Wires = cloneWires(gs.Wires)
+
+	// Clone blocks
+	gs.Checkpoint.Blocks = make([]entities.BlockState, len(gs.Blocks))
+	copy(gs.Checkpoint.Blocks, gs.Blocks)
+
+	// Clone teleporter cooldowns
+	gs.Checkpoint.Teleporters = make([]entities.TeleporterState, len(gs.Teleporters))
+	copy(gs.Checkpoint.Teleporters, gs.Teleporters)
 }
 
 // RestoreCheckpoint restores the game state from checkpoint
@@ -98,16 +137,22 @@ func (gs *State) RestoreCheckpoint() {
 	// Restore player state
 	gs.Player.Position = gs.Checkpoint.PlayerPosition
 	gs.Player.PrevPosition = gs.Checkpoint.PlayerPosition // Set PrevPosition to avoid interpolation issues
+	gs.Player.Moves = nil
 	gs.Player.Keys = gs.Checkpoint.PlayerKeys
 	gs.Player.Bombs = gs.Checkpoint.PlayerBombs
 	gs.Player.BombSlots = gs.Checkpoint.PlayerBombSlots
+	gs.Player.SpecialBombs = gs.Checkpoint.PlayerSpecialBombs
+	gs.Player.Modifiers = gs.Checkpoint.PlayerModifiers
+	gs.Player.SelectedBomb = entities.BombNormal
 	gs.Player.Dead = false
 	gs.Player.Health = 1.0
+	gs.Player.InvulnerableTurns = 0
 
 	// Restore eepers
 	gs.Eepers = make([]entities.EeperState, len(gs.Checkpoint.Eepers))
 	for i := range gs.Checkpoint.Eepers {
 		gs.Eepers[i] = gs.Checkpoint.Eepers[i]
+		gs.Eepers[i].Loot = append([]entities.Item(nil), gs.Checkpoint.Eepers[i].Loot...)
 		// Deep copy the path map
 		if gs.Checkpoint.Eepers[i].Path != nil {
 			gs.Eepers[i].Path = make([][]int, len(gs.Checkpoint.Eepers[i].Path))
@@ -126,11 +171,28 @@ func (gs *State) RestoreCheckpoint() {
 	gs.Bombs = make([]entities.BombState, len(gs.Checkpoint.Bombs))
 	copy(gs.Bombs, gs.Checkpoint.Bombs)
 
-	// Clear explosions
+	// Restore wiring state
+	gs.Wires = cloneWires(gs.Checkpoint.Wires)
+
+	// Restore blocks, settled at their saved positions
+	gs.Blocks = make([]entities.BlockState, len(gs.Checkpoint.Blocks))
+	for i, block := range gs.Checkpoint.Blocks {
+		gs.Blocks[i] = block
+		gs.Blocks[i].PrevPosition = block.Position
+	}
+
+	// Restore teleporters
+	gs.Teleporters = make([]entities.TeleporterState, len(gs.Checkpoint.Teleporters))
+	copy(gs.Teleporters, gs.Checkpoint.Teleporters)
+
+	// Clear explosions and noises
 	gs.Explosions = nil
+	gs.Noises = nil
 
 	// Reset turn animation to prevent visual glitches
 	gs.TurnAnimation = 0
+	gs.RealTimeClock = 0
+	gs.WorldAnimation = 0
 }
 
 // LoadLevel loads a specific level by path
@@ -142,29 +204,99 @@ func (gs *State) LoadLevel(levelPath string, isHub bool) error {
 	// Clear all dynamic game state
 	gs.Bombs = nil
 	gs.Explosions = nil
+	gs.Noises = nil
 	gs.Eepers = nil
 	gs.Items = nil
 	gs.Portals = nil
+	gs.Wires = nil
+	gs.Blocks = nil
+	gs.Teleporters = nil
 	gs.TurnAnimation = 0
+	gs.RealTimeClock = 0
+	gs.WorldAnimation = 0
+
+	// Set current level info
+	gs.CurrentLevelPath = levelPath
+	gs.InHub = isHub
+
+	// Load the optional per-level settings and the rules they override
+	// before spawning anything, since spawns depend on the rules
+	var err error
+	gs.LevelConfig, err = LoadLevelConfig(levelPath)
+	if err != nil {
+		return err
+	}
+	err = gs.applyRules()
+	if err != nil {
+		return err
+	}
 
 	// Load the level
-	err := LoadGameFromImage(levelPath, gs, true)
+	err = LoadGameFromImage(levelPath, gs, true)
 	if err != nil {
 		return err
 	}
 
-	// Set current level info
-	gs.CurrentLevelPath = levelPath
-	gs.InHub = isHub
+	// Hand out the patrol routes to the spawned guards
+	err = gs.assignPatrols()
+	if err != nil {
+		return err
+	}
+
+	// Hand out the keys and barricade breaking to the eepers
+	err = gs.assignAbilities()
+	if err != nil {
+		return err
+	}
+
+	// Set what the spawned mothers split into
+	err = gs.assignMothers()
+	if err != nil {
+		return err
+	}
+
+	// Colour the keys the spawned gnomes drop
+	err = gs.assignGnomeKeys()
+	if err != nil {
+		return err
+	}
+
+	// Set the axes the mirrors flip
+	err = gs.assignMirrors()
+	if err != nil {
+		return err
+	}
+
+	// Pair up the teleporter pads
+	err = gs.linkTeleporters()
+	if err != nil {
+		return err
+	}
+
+	// Connect the plates and levers to their gates
+	gs.Wires, err = gs.buildWires()
+	if err != nil {
+		return err
+	}
+
+	// Make sure the boss's weak points are painted in the level
+	err = gs.checkBossWeakPoints()
+	if err != nil {
+		return err
+	}
 
 	// Reset player state
 	gs.Player.Health = 1.0
+	gs.Player.InvulnerableTurns = 0
 	gs.Player.Dead = false
 	gs.Player.ReachedFather = false
 	gs.Player.VictoryTime = 0
-	gs.Player.BombSlots = 1 // Player starts with 1 bomb slot
-	gs.Player.Bombs = 0     // Player starts with no bombs
-	gs.Player.Keys = 0      // Player starts with no keys
+	gs.Player.BombSlots = 1                     // Player starts with 1 bomb slot
+	gs.Player.Bombs = 0                         // Player starts with no bombs
+	gs.Player.Keys = [world.KeyColorCount]int{} // Player starts with no keys
+	gs.Player.SpecialBombs = [entities.BombKindCount]int{}
+	gs.Player.SelectedBomb = entities.BombNormal
+	gs.Player.Modifiers = entities.PlayerModifiers{} // Power-ups only last for the level
 
 	// Reset camera
 	gs.Camera.Zoom = 1.0
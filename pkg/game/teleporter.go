@@ -0,0 +1,124 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/audio"
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/pathfinding"
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// SpawnTeleporter creates a teleporter pad at the given position
+func (gs *State) SpawnTeleporter(position world.IVector2, id int) {
+	gs.Teleporters = append(gs.Teleporters, entities.TeleporterState{
+		ID:       id,
+		Position: position,
+		Link:     -1,
+	})
+}
+
+// linkTeleporters pairs up the pads sharing an ID. Every ID must be used by exactly two pads.
+func (gs *State) linkTeleporters() error {
+	for i := range gs.Teleporters {
+		pad := &gs.Teleporters[i]
+		pad.Link = -1
+		for j := range gs.Teleporters {
+			if j == i || gs.Teleporters[j].ID != pad.ID {
+				continue
+			}
+			if pad.Link >= 0 {
+				return fmt.Errorf("level %s: more than two teleporter pads with ID %d", gs.CurrentLevelPath, pad.ID)
+			}
+			pad.Link = j
+		}
+		if pad.Link < 0 {
+			return fmt.Errorf("level %s: teleporter pad at %d,%d has no partner", gs.CurrentLevelPath, pad.Position.X, pad.Position.Y)
+		}
+	}
+	return nil
+}
+
+// GetTeleporterAtPosition returns the teleporter pad at the given position, or nil
+func (gs *State) GetTeleporterAtPosition(pos world.IVector2) *entities.TeleporterState {
+	for i := range gs.Teleporters {
+		if gs.Teleporters[i].Position == pos {
+			return &gs.Teleporters[i]
+		}
+	}
+	return nil
+}
+
+// readyTeleporterLink returns the pad linked to the ready pad at pos, or nil when
+// there is no pad or either end is still cooling down
+func (gs *State) readyTeleporterLink(pos world.IVector2) *entities.TeleporterState {
+	pad := gs.GetTeleporterAtPosition(pos)
+	if pad == nil || pad.Cooldown > 0 {
+		return nil
+	}
+	linked := &gs.Teleporters[pad.Link]
+	if linked.Cooldown > 0 {
+		return nil
+	}
+	return linked
+}
+
+// startTeleporterCooldown puts both ends of a pad pair on cooldown
+func (gs *State) startTeleporterCooldown(pad *entities.TeleporterState) {
+	pad.Cooldown = gs.Rules.TeleporterCooldown
+	gs.Teleporters[pad.Link].Cooldown = gs.Rules.TeleporterCooldown
+}
+
+// teleportPlayer moves the player to the linked pad if they are standing on a ready one
+func (gs *State) teleportPlayer() {
+	linked := gs.readyTeleporterLink(gs.Player.Position)
+	if linked == nil || gs.isCellOccupied(linked.Position) {
+		return
+	}
+
+	gs.startTeleporterCooldown(linked)
+	gs.Player.Position = linked.Position
+	gs.Player.PrevPosition = linked.Position // Appear instantly instead of sliding across the map
+	rl.PlaySound(audio.EnterPortalSound)
+}
+
+// teleportEeper moves an eeper whose top-left cell is on a ready pad to the
+// linked pad, if its kind may use teleporters and it fits there.
+// Returns whether the eeper was teleported.
+func (gs *State) teleportEeper(eeper *entities.EeperState) bool {
+	switch eeper.Kind {
+	case entities.EeperGnome:
+		if !gs.Rules.GnomesUseTeleporters {
+			return false
+		}
+	case entities.EeperGuard, entities.EeperMother:
+		if !gs.Rules.GuardsUseTeleporters {
+			return false
+		}
+	default:
+		return false
+	}
+
+	linked := gs.readyTeleporterLink(eeper.Position)
+	if linked == nil || !gs.eeperCanStandHere(linked.Position, eeper) {
+		return false
+	}
+
+	gs.startTeleporterCooldown(linked)
+	eeper.Position = linked.Position
+	return true
+}
+
+// teleporterLinks returns the ready pad pairs as pathfinding edges
+func (gs *State) teleporterLinks() map[pathfinding.Point]pathfinding.Point {
+	links := make(map[pathfinding.Point]pathfinding.Point)
+	for _, pad := range gs.Teleporters {
+		linked := gs.Teleporters[pad.Link]
+		if pad.Cooldown > 0 || linked.Cooldown > 0 {
+			continue
+		}
+		links[pathfinding.Point{X: pad.Position.X, Y: pad.Position.Y}] = pathfinding.Point{X: linked.Position.X, Y: linked.Position.Y}
+	}
+	return links
+}
@@ -0,0 +1,133 @@
+package game
+
+import (
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+)
+
+// slideOnIce returns where something entering position while moving in dir
+// ends up: it keeps sliding over ice until canEnter refuses the next cell or
+// it leaves the ice
+func (gs *State) slideOnIce(position world.IVector2, dir world.IVector2, canEnter func(world.IVector2) bool) world.IVector2 {
+	for gs.WithinMap(position) && gs.Map[position.Y][position.X] == world.CellIce {
+		next := position.Add(dir)
+		if !gs.WithinMap(next) || !canEnter(next) {
+			break
+		}
+		position = next
+	}
+	return position
+}
+
+// moveThroughTerrain returns the cells something of the given size passes
+// after stepping onto position while moving in dir, until the end of the turn:
+// it slides over ice and is then carried one cell by a conveyor. The last cell
+// is where it ends up.
+func (gs *State) moveThroughTerrain(position world.IVector2, dir world.IVector2, size world.IVector2, canEnter func(world.IVector2) bool) []world.IVector2 {
+	cells := []world.IVector2{position}
+	for gs.Map[position.Y][position.X] == world.CellIce {
+		next := position.Add(dir)
+		if !gs.WithinMap(next) || !canEnter(next) {
+			break
+		}
+		position = next
+		cells = append(cells, position)
+	}
+
+	if drift, found := gs.conveyorUnderFootprint(position, size); found {
+		next := position.Add(drift)
+		if gs.WithinMap(next) && canEnter(next) {
+			cells = append(cells, next)
+		}
+	}
+	return cells
+}
+
+// playerCanSlideInto checks whether the sliding player can enter a cell.
+// Pits are entered (and fallen into), anything standing in the way stops the slide.
+func (gs *State) playerCanSlideInto(pos world.IVector2) bool {
+	cell := gs.Map[pos.Y][pos.X]
+	return (cell.IsWalkable() || cell == world.CellPit) && !gs.isCellOccupied(pos)
+}
+
+// slideGnome lets a gnome that just stepped onto ice slide in its direction of movement
+func (gs *State) slideGnome(eeper *entities.EeperState, oldPosition world.IVector2) {
+	dir := eeper.Position.Sub(oldPosition)
+	if dir == (world.IVector2{}) {
+		return
+	}
+	eeper.Position = gs.slideOnIce(eeper.Position, dir, func(pos world.IVector2) bool {
+		return gs.eeperCanStandHere(pos, eeper) && pos != gs.Player.Position
+	})
+}
+
+// checkPlayerFall kills the player if they are standing in a pit
+func (gs *State) checkPlayerFall() {
+	if gs.Map[gs.Player.Position.Y][gs.Player.Position.X] == world.CellPit {
+		gs.KillPlayer()
+	}
+}
+
+// TerrainTurn moves everything standing on a conveyor one cell in its direction
+func (gs *State) TerrainTurn() {
+	// Player
+	if dir, found := gs.Map[gs.Player.Position.Y][gs.Player.Position.X].ConveyorDirection(); found && !gs.Player.Dead {
+		next := gs.Player.Position.Add(dir)
+		if gs.WithinMap(next) && gs.playerCanSlideInto(next) {
+			gs.Player.Position = next
+			gs.checkPlayerFall()
+		}
+	}
+
+	// Eepers are carried by the first conveyor under their footprint
+	for i := range gs.Eepers {
+		eeper := &gs.Eepers[i]
+		if eeper.Dead {
+			continue
+		}
+		dir, found := gs.conveyorUnderFootprint(eeper.Position, eeper.Size)
+		if !found {
+			continue
+		}
+		// The player stops the belt like a wall, as they stop a sliding gnome
+		next := eeper.Position.Add(dir)
+		if gs.eeperCanStandHere(next, eeper) && !gs.footprintCovers(next, eeper.Size, gs.Player.Position) {
+			eeper.Position = next
+		}
+	}
+
+	// Bombs
+	for i := range gs.Bombs {
+		bomb := &gs.Bombs[i]
+		dir, found := gs.Map[bomb.Position.Y][bomb.Position.X].ConveyorDirection()
+		if !found {
+			continue
+		}
+		next := bomb.Position.Add(dir)
+		if gs.WithinMap(next) && gs.Map[next.Y][next.X].IsWalkable() && !gs.isCellOccupied(next) {
+			bomb.Position = next
+		}
+	}
+}
+
+// footprintCovers checks whether a footprint at position covers the cell
+func (gs *State) footprintCovers(position world.IVector2, size world.IVector2, cell world.IVector2) bool {
+	return cell.X >= position.X && cell.X < position.X+size.X &&
+		cell.Y >= position.Y && cell.Y < position.Y+size.Y
+}
+
+// conveyorUnderFootprint returns the direction of the first conveyor cell covered by a footprint
+func (gs *State) conveyorUnderFootprint(position world.IVector2, size world.IVector2) (world.IVector2, bool) {
+	for y := position.Y; y < position.Y+size.Y; y++ {
+		for x := position.X; x < position.X+size.X; x++ {
+			cell := world.IVector2{X: x, Y: y}
+			if !gs.WithinMap(cell) {
+				continue
+			}
+			if dir, found := gs.Map[y][x].ConveyorDirection(); found {
+				return dir, true
+			}
+		}
+	}
+	return world.IVector2{}, false
+}
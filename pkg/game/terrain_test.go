@@ -0,0 +1,53 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+)
+
+// conveyorState builds a walled room with a right-moving belt along y=2
+// under a guard at 1,1 and the player at the given position
+func conveyorState(player world.IVector2) *State {
+	const width, height = 9, 5
+
+	gs := &State{Rules: DefaultRules()}
+	gs.Map = make([][]world.Cell, height)
+	for y := range gs.Map {
+		gs.Map[y] = make([]world.Cell, width)
+		for x := range gs.Map[y] {
+			switch {
+			case y == 0 || y == height-1 || x == 0 || x == width-1:
+				gs.Map[y][x] = world.CellWall
+			case y == 2:
+				gs.Map[y][x] = world.CellConveyorRight
+			default:
+				gs.Map[y][x] = world.CellFloor
+			}
+		}
+	}
+
+	gs.Player.Position = player
+	gs.SpawnGuard(world.IVector2{X: 1, Y: 1})
+	return gs
+}
+
+func TestConveyorCarriesEeper(t *testing.T) {
+	gs := conveyorState(world.IVector2{X: 7, Y: 2})
+
+	gs.TerrainTurn()
+
+	if pos := gs.Eepers[0].Position; pos.X != 2 {
+		t.Errorf("guard at x=%d after a conveyor turn, want 2", pos.X)
+	}
+}
+
+func TestConveyorDoesNotCarryEeperOntoPlayer(t *testing.T) {
+	gs := conveyorState(world.IVector2{X: 4, Y: 3})
+
+	gs.TerrainTurn()
+
+	if pos := gs.Eepers[0].Position; pos.X != 1 {
+		t.Errorf("guard carried onto the player to x=%d, want it to stay at 1", pos.X)
+	}
+}
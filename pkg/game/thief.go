@@ -0,0 +1,44 @@
+package game
+
+import (
+	"github.com/engpetarmarinov/eepers-go/pkg/audio"
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// SpawnThief creates a gnome that steals the keys and bomb refills it walks over
+func (gs *State) SpawnThief(position world.IVector2) {
+	gs.SpawnGnome(position)
+	gs.Eepers[len(gs.Eepers)-1].Thief = true
+}
+
+// stealItems lets a thief gnome pick up the keys and available bomb refills on its cell
+func (gs *State) stealItems(eeper *entities.EeperState) {
+	for i := range gs.Items {
+		item := &gs.Items[i]
+		if item.Position != eeper.Position {
+			continue
+		}
+
+		switch {
+		case item.Kind == entities.ItemKey:
+		case item.Kind == entities.ItemBombRefill && item.Cooldown <= 0:
+		default:
+			continue
+		}
+
+		eeper.Loot = append(eeper.Loot, *item)
+		item.Kind = entities.ItemNone // Mark as stolen
+		rl.PlaySound(audio.KeyPickupSound)
+	}
+}
+
+// dropLoot drops everything a thief gnome stole where it died
+func (gs *State) dropLoot(eeper *entities.EeperState) {
+	for _, item := range eeper.Loot {
+		item.Position = eeper.Position
+		gs.Items = append(gs.Items, item)
+	}
+	eeper.Loot = nil
+}
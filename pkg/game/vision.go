@@ -0,0 +1,101 @@
+package game
+
+import (
+	"math"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+)
+
+// EeperCenter returns the center of the eeper's footprint in cell units
+func EeperCenter(eeper *entities.EeperState) (float64, float64) {
+	return float64(eeper.Position.X) + float64(eeper.Size.X)/2, float64(eeper.Position.Y) + float64(eeper.Size.Y)/2
+}
+
+// EeperFacing returns the unit direction the eeper is looking at, derived from its eyes target
+func EeperFacing(eeper *entities.EeperState) (float64, float64) {
+	centerX, centerY := EeperCenter(eeper)
+	dx := float64(eeper.EyesTarget.X) + 0.5 - centerX
+	dy := float64(eeper.EyesTarget.Y) + 0.5 - centerY
+	length := math.Hypot(dx, dy)
+	if length < 0.01 {
+		return 0, 1 // Looking down by default
+	}
+	return dx / length, dy / length
+}
+
+// EeperVisionRange returns how far the eeper can currently see in cells.
+// Sleeping eepers only notice the player at half the range.
+func (gs *State) EeperVisionRange(eeper *entities.EeperState) float64 {
+	visionRange := float64(gs.Rules.GuardVisionRange)
+	if eeper.Behaviour == entities.BehaviourSleep {
+		visionRange /= 2
+	}
+	return visionRange
+}
+
+// eeperCanSeePlayer checks whether the player is inside the eeper's view cone
+// and not hidden behind opaque cells
+func (gs *State) eeperCanSeePlayer(eeper *entities.EeperState) bool {
+	centerX, centerY := EeperCenter(eeper)
+	toPlayerX := float64(gs.Player.Position.X) + 0.5 - centerX
+	toPlayerY := float64(gs.Player.Position.Y) + 0.5 - centerY
+	distance := math.Hypot(toPlayerX, toPlayerY)
+
+	if distance > gs.EeperVisionRange(eeper) {
+		return false
+	}
+
+	// The player is always noticed when touching the eeper's footprint
+	halfSize := math.Max(float64(eeper.Size.X), float64(eeper.Size.Y)) / 2
+	if distance > halfSize+1 {
+		facingX, facingY := EeperFacing(eeper)
+		cosAngle := (facingX*toPlayerX + facingY*toPlayerY) / distance
+		if cosAngle < math.Cos(float64(gs.Rules.GuardVisionAngle)*math.Pi/180) {
+			return false
+		}
+	}
+
+	return gs.hasLineOfSight(world.IVector2{X: int(centerX), Y: int(centerY)}, gs.Player.Position)
+}
+
+// hasLineOfSight checks that no opaque cell lies between two cells
+func (gs *State) hasLineOfSight(from, to world.IVector2) bool {
+	for _, cell := range world.Line(from, to) {
+		if !gs.WithinMap(cell) {
+			return false
+		}
+		if cell != from && cell != to && gs.Map[cell.Y][cell.X].IsOpaque() {
+			return false
+		}
+	}
+	return true
+}
+
+// updateEeperAlert raises the eeper's alert meter while it sees the player
+// (faster when the player is close) and lets it decay otherwise.
+// Returns whether the player is seen this turn.
+func (gs *State) updateEeperAlert(eeper *entities.EeperState) bool {
+	if !gs.eeperCanSeePlayer(eeper) {
+		eeper.Alert -= gs.Rules.AlertDecay
+		if eeper.Alert < 0 {
+			eeper.Alert = 0
+		}
+		return false
+	}
+
+	eeper.LastKnownPosition = gs.Player.Position
+
+	gain := gs.Rules.AlertGain
+	centerX, centerY := EeperCenter(eeper)
+	distance := math.Hypot(float64(gs.Player.Position.X)+0.5-centerX, float64(gs.Player.Position.Y)+0.5-centerY)
+	if distance <= float64(gs.Rules.GuardVisionRange)/2 {
+		gain *= 2
+	}
+
+	eeper.Alert += gain
+	if eeper.Alert > 1 {
+		eeper.Alert = 1
+	}
+	return true
+}
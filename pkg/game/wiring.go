@@ -0,0 +1,117 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+)
+
+// buildWires creates the wires configured for the level, checking that every
+// trigger is a plate or lever and every gate is a gate cell
+func (gs *State) buildWires() ([]entities.WireState, error) {
+	var wires []entities.WireState
+	for _, config := range gs.LevelConfig.Wires {
+		wire := entities.WireState{
+			Triggers:  config.Triggers,
+			Gates:     config.Gates,
+			Pressed:   make([]bool, len(config.Triggers)),
+			GatesOpen: make([]bool, len(config.Gates)),
+		}
+
+		for _, trigger := range config.Triggers {
+			if !gs.WithinMap(trigger) || (gs.Map[trigger.Y][trigger.X] != world.CellPlate && gs.Map[trigger.Y][trigger.X] != world.CellLever) {
+				return nil, fmt.Errorf("level %s: no plate or lever at %d,%d for wire", gs.CurrentLevelPath, trigger.X, trigger.Y)
+			}
+		}
+		for i, gate := range config.Gates {
+			if !gs.WithinMap(gate) || (gs.Map[gate.Y][gate.X] != world.CellGateClosed && gs.Map[gate.Y][gate.X] != world.CellGateOpen) {
+				return nil, fmt.Errorf("level %s: no gate at %d,%d for wire", gs.CurrentLevelPath, gate.X, gate.Y)
+			}
+			wire.GatesOpen[i] = gs.Map[gate.Y][gate.X] == world.CellGateOpen
+		}
+
+		wires = append(wires, wire)
+	}
+
+	return wires, nil
+}
+
+// cloneWires deep copies the wires for checkpoints
+func cloneWires(wires []entities.WireState) []entities.WireState {
+	if wires == nil {
+		return nil
+	}
+	clone := make([]entities.WireState, len(wires))
+	for i, wire := range wires {
+		clone[i] = wire
+		clone[i].Pressed = append([]bool(nil), wire.Pressed...)
+		clone[i].GatesOpen = append([]bool(nil), wire.GatesOpen...)
+	}
+	return clone
+}
+
+// UpdateWiring toggles the gates of every wire whose plates or levers changed
+// since the last update and moves the gates to their new state
+func (gs *State) UpdateWiring() {
+	for i := range gs.Wires {
+		wire := &gs.Wires[i]
+
+		for j, trigger := range wire.Triggers {
+			pressed := gs.isTriggerPressed(trigger)
+			if pressed == wire.Pressed[j] {
+				continue
+			}
+			wire.Pressed[j] = pressed
+
+			// Plates flip their gates both ways, levers only when pressed
+			if pressed || gs.Map[trigger.Y][trigger.X] == world.CellPlate {
+				for k := range wire.GatesOpen {
+					wire.GatesOpen[k] = !wire.GatesOpen[k]
+				}
+			}
+		}
+
+		for j, gate := range wire.Gates {
+			if wire.GatesOpen[j] {
+				gs.Map[gate.Y][gate.X] = world.CellGateOpen
+			} else if !gs.isCellOccupied(gate) {
+				// Never crush anything standing in the gate
+				gs.Map[gate.Y][gate.X] = world.CellGateClosed
+			}
+		}
+	}
+}
+
+// isTriggerPressed checks whether the player, an eeper's footprint, a bomb or
+// an explosion is on the trigger cell
+func (gs *State) isTriggerPressed(pos world.IVector2) bool {
+	if gs.isCellOccupied(pos) {
+		return true
+	}
+	for _, explosion := range gs.Explosions {
+		if explosion.Position == pos {
+			return true
+		}
+	}
+	return false
+}
+
+// isCellOccupied checks whether the player, an eeper's footprint or a bomb is on the cell
+func (gs *State) isCellOccupied(pos world.IVector2) bool {
+	if gs.Player.Position == pos {
+		return true
+	}
+	for i := range gs.Eepers {
+		eeper := &gs.Eepers[i]
+		if !eeper.Dead && gs.isInsideRect(eeper.Position, eeper.Size, pos) {
+			return true
+		}
+	}
+	for _, bomb := range gs.Bombs {
+		if bomb.Position == pos {
+			return true
+		}
+	}
+	return false
+}
@@ -22,12 +22,19 @@ type InputState struct {
 	MoveUp           bool
 	MoveDown         bool
 	PlaceBomb        bool
+	CycleBomb        bool // Tab key or X button (select the next bomb kind)
+	DetonateBombs    bool // E key or Y button (set off remote bombs)
 	IsRunning        bool
 	IsPressed        bool // For turn-based movement (false means held down for continuous)
 	MenuToggle       bool // ESC key or Menu/Start button
 	MenuConfirm      bool // Enter key or A button (when in menu)
 	MenuNavigateUp   bool // Up arrow/stick (for menu navigation)
 	MenuNavigateDown bool // Down arrow/stick (for menu navigation)
+	DebugToggle      bool // F3 key (toggle debug overlay)
+	DebugNextEeper   bool // F4 key (inspect next eeper in debug overlay)
+	ConsoleToggle    bool // Backtick key (toggle developer console)
+	SnapshotSave     bool // F5 key (save a JSON snapshot of the game state)
+	SnapshotLoad     bool // F9 key (load the JSON snapshot of the game state)
 }
 
 // AnalogState tracks previous analog stick state for detecting new presses
@@ -61,6 +68,8 @@ func GetInput() InputState {
 	input.MoveUp = keyboardInput.MoveUp || gamepadInput.MoveUp
 	input.MoveDown = keyboardInput.MoveDown || gamepadInput.MoveDown
 	input.PlaceBomb = keyboardInput.PlaceBomb || gamepadInput.PlaceBomb
+	input.CycleBomb = keyboardInput.CycleBomb || gamepadInput.CycleBomb
+	input.DetonateBombs = keyboardInput.DetonateBombs || gamepadInput.DetonateBombs
 
 	// Menu inputs
 	input.MenuToggle = keyboardInput.MenuToggle || gamepadInput.MenuToggle
@@ -68,6 +77,13 @@ func GetInput() InputState {
 	input.MenuNavigateUp = keyboardInput.MenuNavigateUp || gamepadInput.MenuNavigateUp
 	input.MenuNavigateDown = keyboardInput.MenuNavigateDown || gamepadInput.MenuNavigateDown
 
+	// Debug inputs (keyboard only)
+	input.DebugToggle = keyboardInput.DebugToggle
+	input.DebugNextEeper = keyboardInput.DebugNextEeper
+	input.ConsoleToggle = keyboardInput.ConsoleToggle
+	input.SnapshotSave = keyboardInput.SnapshotSave
+	input.SnapshotLoad = keyboardInput.SnapshotLoad
+
 	// Running mode is active if either keyboard shift OR gamepad trigger is held
 	input.IsRunning = keyboardInput.IsRunning || gamepadInput.IsRunning
 
@@ -167,6 +183,10 @@ func getGamepadInput() InputState {
 	// A button for placing bombs (like Space key)
 	input.PlaceBomb = rl.IsGamepadButtonPressed(GamepadPlayer1, rl.GamepadButtonRightFaceDown)
 
+	// X button selects the next bomb kind, Y sets off remote bombs
+	input.CycleBomb = rl.IsGamepadButtonPressed(GamepadPlayer1, rl.GamepadButtonRightFaceLeft)
+	input.DetonateBombs = rl.IsGamepadButtonPressed(GamepadPlayer1, rl.GamepadButtonRightFaceUp)
+
 	// Menu controls
 	// Start/Menu button to toggle menu (button 7 on most controllers)
 	input.MenuToggle = rl.IsGamepadButtonPressed(GamepadPlayer1, rl.GamepadButtonMiddleRight)
@@ -207,12 +227,23 @@ func getKeyboardInput() InputState {
 	// Space for placing bombs
 	input.PlaceBomb = rl.IsKeyPressed(rl.KeySpace)
 
+	// Tab selects the next bomb kind, E sets off remote bombs
+	input.CycleBomb = rl.IsKeyPressed(rl.KeyTab)
+	input.DetonateBombs = rl.IsKeyPressed(rl.KeyE)
+
 	// Menu controls
 	input.MenuToggle = rl.IsKeyPressed(rl.KeyEscape)
 	input.MenuConfirm = rl.IsKeyPressed(rl.KeyEnter) || rl.IsKeyPressed(rl.KeyKpEnter)
 	input.MenuNavigateUp = rl.IsKeyPressed(rl.KeyUp) || rl.IsKeyPressed(rl.KeyW)
 	input.MenuNavigateDown = rl.IsKeyPressed(rl.KeyDown) || rl.IsKeyPressed(rl.KeyS)
 
+	// Debug controls
+	input.DebugToggle = rl.IsKeyPressed(rl.KeyF3)
+	input.DebugNextEeper = rl.IsKeyPressed(rl.KeyF4)
+	input.ConsoleToggle = rl.IsKeyPressed(rl.KeyGrave)
+	input.SnapshotSave = rl.IsKeyPressed(rl.KeyF5)
+	input.SnapshotLoad = rl.IsKeyPressed(rl.KeyF9)
+
 	return input
 }
 
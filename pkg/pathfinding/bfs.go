@@ -38,7 +38,7 @@ func BFS(grid [][]world.Cell, start, end Point) []Point {
 }
 
 func isValid(p Point, grid [][]world.Cell) bool {
-	return p.Y >= 0 && p.Y < len(grid) && p.X >= 0 && p.X < len(grid[0]) && grid[p.Y][p.X] == world.CellFloor
+	return p.Y >= 0 && p.Y < len(grid) && p.X >= 0 && p.X < len(grid[0]) && grid[p.Y][p.X].IsWalkable()
 }
 
 // ComputeDistanceMap creates a distance map from the target position.
@@ -54,31 +54,22 @@ func ComputeDistanceMap(
 	stepLengthLimit int,
 	canStandFunc func(Point) bool,
 ) [][]int {
-	height := len(grid)
-	width := len(grid[0])
-
-	// Initialize distance map with -1 (unreachable)
-	distMap := make([][]int, height)
-	for i := range distMap {
-		distMap[i] = make([]int, width)
-		for j := range distMap[i] {
-			distMap[i][j] = -1
-		}
-	}
-
-	// Queue for BFS
-	queue := []Point{}
+	return ComputeDistanceMapWithLinks(grid, target, targetSize, stepsLimit, stepLengthLimit, canStandFunc, nil)
+}
 
-	// Mark all positions where the entity could overlap with the target as distance 0
-	for dy := 0; dy < targetSize.Y; dy++ {
-		for dx := 0; dx < targetSize.X; dx++ {
-			pos := Point{X: target.X - dx, Y: target.Y - dy}
-			if canStandFunc(pos) {
-				distMap[pos.Y][pos.X] = 0
-				queue = append(queue, pos)
-			}
-		}
-	}
+// ComputeDistanceMapWithLinks works like ComputeDistanceMap, but additionally
+// treats every entry of links as a one step edge from the key position to the
+// value position (e.g. teleporter pads). Links should be symmetric.
+func ComputeDistanceMapWithLinks(
+	grid [][]world.Cell,
+	target Point,
+	targetSize Point,
+	stepsLimit int,
+	stepLengthLimit int,
+	canStandFunc func(Point) bool,
+	links map[Point]Point,
+) [][]int {
+	distMap, queue := newDistanceMap(grid, target, targetSize, canStandFunc)
 
 	// BFS with step length
 	directions := []Point{{0, 1}, {0, -1}, {1, 0}, {-1, 0}}
@@ -115,7 +106,90 @@ func ComputeDistanceMap(
 				newPos = Point{X: newPos.X + dir.X, Y: newPos.Y + dir.Y}
 			}
 		}
+
+		// Standing on a linked position gets you to this one in one step
+		if linked, found := links[pos]; found && canStandFunc(linked) && distMap[linked.Y][linked.X] == -1 {
+			distMap[linked.Y][linked.X] = currentDist + 1
+			queue = append(queue, linked)
+		}
+	}
+
+	return distMap
+}
+
+// ComputeDistanceMapWithMoves works like ComputeDistanceMap with single cell
+// steps, but lets moveFunc resolve where a step actually takes the entity
+// (e.g. sliding over ice or riding a conveyor). moveFunc returns the cells the
+// step passes through, ending with the one it stops on, or nothing when the
+// step is blocked. Passed cells get a distance too, but only the cell the step
+// stops on is searched further.
+func ComputeDistanceMapWithMoves(
+	grid [][]world.Cell,
+	target Point,
+	targetSize Point,
+	stepsLimit int,
+	canStandFunc func(Point) bool,
+	moveFunc func(from Point, dir Point) []Point,
+) [][]int {
+	distMap, queue := newDistanceMap(grid, target, targetSize, canStandFunc)
+
+	directions := []Point{{0, 1}, {0, -1}, {1, 0}, {-1, 0}}
+
+	for len(queue) > 0 {
+		pos := queue[0]
+		queue = queue[1:]
+
+		currentDist := distMap[pos.Y][pos.X]
+		if currentDist >= stepsLimit {
+			continue
+		}
+
+		for _, dir := range directions {
+			cells := moveFunc(pos, dir)
+			for i, cell := range cells {
+				if distMap[cell.Y][cell.X] != -1 {
+					continue
+				}
+				distMap[cell.Y][cell.X] = currentDist + 1
+				if i == len(cells)-1 {
+					queue = append(queue, cell)
+				}
+			}
+		}
 	}
 
 	return distMap
 }
+
+// newDistanceMap creates a distance map with every cell unreachable except
+// the positions where the entity overlaps with the target, which are returned
+// as the start of the search
+func newDistanceMap(grid [][]world.Cell, target Point, targetSize Point, canStandFunc func(Point) bool) ([][]int, []Point) {
+	height := len(grid)
+	width := len(grid[0])
+
+	// Initialize distance map with -1 (unreachable)
+	distMap := make([][]int, height)
+	for i := range distMap {
+		distMap[i] = make([]int, width)
+		for j := range distMap[i] {
+			distMap[i][j] = -1
+		}
+	}
+
+	// Queue for BFS
+	queue := []Point{}
+
+	// Mark all positions where the entity could overlap with the target as distance 0
+	for dy := 0; dy < targetSize.Y; dy++ {
+		for dx := 0; dx < targetSize.X; dx++ {
+			pos := Point{X: target.X - dx, Y: target.Y - dy}
+			if canStandFunc(pos) {
+				distMap[pos.Y][pos.X] = 0
+				queue = append(queue, pos)
+			}
+		}
+	}
+
+	return distMap, queue
+}
@@ -0,0 +1,18 @@
+package ui
+
+import (
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/palette"
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// DrawBlock draws a pushable block, interpolated between its previous and current position
+func DrawBlock(block entities.BlockState, turnAnimation float32) {
+	prevPos := rl.NewVector2(float32(block.PrevPosition.X*50), float32(block.PrevPosition.Y*50))
+	pos := rl.NewVector2(float32(block.Position.X*50), float32(block.Position.Y*50))
+	interpPos := rl.Vector2Lerp(pos, prevPos, turnAnimation)
+
+	color := palette.Colors["COLOR_BLOCK"]
+	rl.DrawRectangleV(rl.NewVector2(interpPos.X+3, interpPos.Y+3), rl.NewVector2(44, 44), color)
+	rl.DrawRectangleLinesEx(rl.NewRectangle(interpPos.X+3, interpPos.Y+3, 44, 44), 3, rl.ColorBrightness(color, -0.4))
+}
@@ -0,0 +1,54 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/palette"
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// DrawBombIcon draws a bomb of the given kind centered at center, with a
+// marking telling the kinds apart
+func DrawBombIcon(kind entities.BombKind, center rl.Vector2, radius float32) {
+	color := palette.Colors["COLOR_BOMB"]
+	if kind == entities.BombLongFuse {
+		color = rl.ColorBrightness(color, -0.35)
+	}
+	rl.DrawCircleV(center, radius, color)
+
+	markColor := rl.Fade(rl.White, 0.6)
+	switch kind {
+	case entities.BombRemote:
+		// Antenna sticking out of the top
+		top := rl.NewVector2(center.X+radius*0.5, center.Y-radius*1.4)
+		rl.DrawLineEx(rl.NewVector2(center.X+radius*0.3, center.Y-radius*0.8), top, 3, markColor)
+		rl.DrawCircleV(top, radius*0.2, rl.Red)
+	case entities.BombLongFuse:
+		// Long fuse curling out of the top
+		rl.DrawLineEx(rl.NewVector2(center.X, center.Y-radius), rl.NewVector2(center.X+radius*0.6, center.Y-radius*1.5), 3, markColor)
+		rl.DrawLineEx(rl.NewVector2(center.X+radius*0.6, center.Y-radius*1.5), rl.NewVector2(center.X+radius*1.1, center.Y-radius*1.2), 3, markColor)
+	case entities.BombCross:
+		// Diagonal cross showing the blast pattern
+		offset := radius * 0.6
+		rl.DrawLineEx(rl.NewVector2(center.X-offset, center.Y-offset), rl.NewVector2(center.X+offset, center.Y+offset), 3, markColor)
+		rl.DrawLineEx(rl.NewVector2(center.X+offset, center.Y-offset), rl.NewVector2(center.X-offset, center.Y+offset), 3, markColor)
+	}
+}
+
+// DrawBomb draws a planted bomb with its countdown (remote bombs show no countdown).
+// Real-time mode shows the countdown as a fractional timer.
+func DrawBomb(bomb entities.BombState, timeLeft float32, fractional bool) {
+	center := rl.NewVector2(float32(bomb.Position.X*50+25), float32(bomb.Position.Y*50+25))
+	DrawBombIcon(bomb.Kind, center, 20)
+
+	if bomb.Kind == entities.BombRemote {
+		return
+	}
+	countdownText := fmt.Sprintf("%d", bomb.Countdown)
+	if fractional {
+		countdownText = fmt.Sprintf("%.1f", timeLeft)
+	}
+	textWidth := rl.MeasureText(countdownText, 20)
+	rl.DrawText(countdownText, int32(center.X)-textWidth/2, int32(center.Y)-10, 20, rl.White)
+}
@@ -0,0 +1,47 @@
+package ui
+
+import (
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/game"
+	"github.com/engpetarmarinov/eepers-go/pkg/palette"
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// DrawBossHealthBars draws a large health bar at the bottom of the screen for
+// every boss that is fighting the player, with marks where its phases start
+func DrawBossHealthBars(gs *game.State, screenWidth int32, screenHeight int32) {
+	barWidth := float32(screenWidth) * 0.6
+	barHeight := float32(24.0)
+	barY := float32(screenHeight) - 60
+
+	for i := range gs.Eepers {
+		eeper := &gs.Eepers[i]
+		if eeper.Dead || eeper.Kind != entities.EeperBoss {
+			continue
+		}
+		if eeper.Behaviour != entities.BehaviourChase && eeper.Health >= 1.0 {
+			continue // The fight has not started yet
+		}
+
+		barPos := rl.NewVector2((float32(screenWidth)-barWidth)*0.5, barY)
+		rl.DrawRectangleV(barPos, rl.NewVector2(barWidth, barHeight), rl.NewColor(40, 40, 40, 220))
+
+		// Shielded bosses show their health greyed out
+		fillColor := palette.Colors["COLOR_BOSS"]
+		if gs.BossShielded(eeper) {
+			fillColor = rl.Gray
+		}
+		rl.DrawRectangleV(barPos, rl.NewVector2(barWidth*eeper.Health, barHeight), fillColor)
+
+		for phase, config := range gs.LevelConfig.Boss.Phases {
+			if phase == 0 {
+				continue
+			}
+			markX := barPos.X + barWidth*config.Health
+			rl.DrawLineEx(rl.NewVector2(markX, barPos.Y), rl.NewVector2(markX, barPos.Y+barHeight), 3, rl.White)
+		}
+
+		rl.DrawText("BOSS", int32(barPos.X), int32(barPos.Y)-26, 20, palette.Colors["COLOR_LABEL"])
+		barY -= barHeight + 40
+	}
+}
@@ -0,0 +1,114 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/game"
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// DrawDebugOverlay draws the selected eeper's distance map, move candidates and
+// footprint in world space (must be called inside Mode2D)
+func DrawDebugOverlay(gs *game.State) {
+	if !gs.Debug.Enabled {
+		return
+	}
+
+	eeper := gs.SelectedDebugEeper()
+	if eeper == nil {
+		return
+	}
+
+	// Draw distance numbers on every reachable cell
+	if eeper.Path != nil {
+		fontSize := int32(16)
+		for y, row := range eeper.Path {
+			for x, dist := range row {
+				if dist < 0 {
+					continue
+				}
+				distText := fmt.Sprintf("%d", dist)
+				textWidth := rl.MeasureText(distText, fontSize)
+				rl.DrawText(distText, int32(x*50+25)-textWidth/2, int32(y*50+25)-fontSize/2, fontSize, rl.Fade(rl.White, 0.8))
+			}
+		}
+	}
+
+	// Highlight the footprints the eeper could jump to on its next move
+	for _, candidate := range gs.GuardMoveCandidates(eeper) {
+		rl.DrawRectangle(int32(candidate.X*50), int32(candidate.Y*50), int32(eeper.Size.X*50), int32(eeper.Size.Y*50), rl.Fade(rl.Yellow, 0.2))
+		rl.DrawRectangleLines(int32(candidate.X*50), int32(candidate.Y*50), int32(eeper.Size.X*50), int32(eeper.Size.Y*50), rl.Yellow)
+	}
+
+	// Mark the patrol waypoints, highlighting the one the eeper is walking to
+	for i, waypoint := range eeper.Patrol {
+		color := rl.SkyBlue
+		if i == eeper.PatrolIndex {
+			color = rl.Blue
+		}
+		rl.DrawCircle(int32(waypoint.X*50+25), int32(waypoint.Y*50+25), 10, color)
+	}
+
+	// Outline the eeper's current footprint
+	footprint := rl.NewRectangle(float32(eeper.Position.X*50), float32(eeper.Position.Y*50), float32(eeper.Size.X*50), float32(eeper.Size.Y*50))
+	rl.DrawRectangleLinesEx(footprint, 3, rl.Red)
+}
+
+// DrawDebugPanel draws the selected eeper's AI state in screen space
+func DrawDebugPanel(gs *game.State, screenWidth int32) {
+	if !gs.Debug.Enabled {
+		return
+	}
+
+	panelWidth := int32(320)
+	panelX := screenWidth - panelWidth - 10
+	panelY := int32(40)
+	lineHeight := int32(22)
+	fontSize := int32(20)
+
+	lines := []string{"DEBUG (F3 hide, F4 next eeper)"}
+
+	eeper := gs.SelectedDebugEeper()
+	if eeper == nil {
+		lines = append(lines, "No eeper selected")
+	} else {
+		dist := -1
+		if eeper.Path != nil {
+			dist = eeper.Path[eeper.Position.Y][eeper.Position.X]
+		}
+		lines = append(lines,
+			fmt.Sprintf("Eeper #%d: %s", gs.Debug.SelectedEeper, eeper.Kind),
+			fmt.Sprintf("Position: %d,%d  Size: %dx%d", eeper.Position.X, eeper.Position.Y, eeper.Size.X, eeper.Size.Y),
+			fmt.Sprintf("Distance to player: %d", dist),
+			fmt.Sprintf("Attack cooldown: %d", eeper.AttackCooldown),
+			fmt.Sprintf("Health: %.2f", eeper.Health),
+			fmt.Sprintf("Eyes: %s", eeper.Eyes),
+			fmt.Sprintf("Behaviour: %s", eeper.Behaviour),
+			fmt.Sprintf("Effects: %s", formatEffects(eeper)),
+			fmt.Sprintf("Alert: %.2f  Last seen: %d,%d", eeper.Alert, eeper.LastKnownPosition.X, eeper.LastKnownPosition.Y),
+			fmt.Sprintf("Move candidates: %d", len(gs.GuardMoveCandidates(eeper))),
+		)
+	}
+
+	panelHeight := int32(len(lines))*lineHeight + 10
+	rl.DrawRectangle(panelX, panelY, panelWidth, panelHeight, rl.Fade(rl.Black, 0.7))
+	for i, line := range lines {
+		rl.DrawText(line, panelX+5, panelY+5+int32(i)*lineHeight, fontSize, rl.White)
+	}
+}
+
+// formatEffects lists the active status effects of an eeper with their turns left
+func formatEffects(eeper *entities.EeperState) string {
+	var effects []string
+	for effect, turns := range eeper.Effects {
+		if turns > 0 {
+			effects = append(effects, fmt.Sprintf("%s %d", entities.StatusEffect(effect), turns))
+		}
+	}
+	if len(effects) == 0 {
+		return "none"
+	}
+	return strings.Join(effects, ", ")
+}
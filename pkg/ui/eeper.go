@@ -52,3 +52,20 @@ func DrawEeperCooldownBubble(eeper entities.EeperState, interpPos rl.Vector2, si
 
 	rl.DrawText(countdownText, int32(textPos.X), int32(textPos.Y), fontSize, rl.Black)
 }
+
+// DrawEeperAlertMeter draws a meter above an eeper that fills up while it notices the player
+func DrawEeperAlertMeter(eeper entities.EeperState, interpPos rl.Vector2, size rl.Vector2) {
+	if eeper.Alert <= 0 || eeper.Behaviour == entities.BehaviourChase {
+		return
+	}
+
+	meterWidth := size.X * 0.5
+	meterHeight := float32(8.0)
+	meterPos := rl.NewVector2(interpPos.X+(size.X-meterWidth)*0.5, interpPos.Y-meterHeight-12)
+
+	rl.DrawRectangleV(meterPos, rl.NewVector2(meterWidth, meterHeight), rl.NewColor(40, 40, 40, 200))
+	rl.DrawRectangleV(meterPos, rl.NewVector2(meterWidth*eeper.Alert, meterHeight), rl.Orange)
+
+	// Exclamation mark next to the meter
+	rl.DrawText("!", int32(meterPos.X+meterWidth+6), int32(meterPos.Y-6), 20, rl.Orange)
+}
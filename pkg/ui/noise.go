@@ -0,0 +1,14 @@
+package ui
+
+import (
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// DrawNoise draws a noise as a ring expanding to its radius and fading out
+func DrawNoise(noise entities.NoiseState) {
+	progress := 1 - float32(noise.Timer)/float32(noise.InitialTimer)
+	center := rl.NewVector2(float32(noise.Position.X*50+25), float32(noise.Position.Y*50+25))
+	radius := progress * float32(noise.Radius*50)
+	rl.DrawRing(center, radius-3, radius, 0, 360, 48, rl.Fade(rl.White, 0.4*(1-progress)))
+}
@@ -0,0 +1,26 @@
+package ui
+
+import (
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/palette"
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// powerUpLetters labels each power-up kind on the map
+var powerUpLetters = map[entities.ItemKind]string{
+	entities.ItemPowerBlastRange: "R",
+	entities.ItemPowerShortFuse:  "F",
+	entities.ItemPowerKick:       "K",
+	entities.ItemPowerImmunity:   "I",
+}
+
+// DrawPowerUp draws a power-up item as a lettered tile centered at center
+func DrawPowerUp(kind entities.ItemKind, center rl.Vector2) {
+	tile := rl.NewRectangle(center.X-18, center.Y-18, 36, 36)
+	rl.DrawRectangleRounded(tile, 0.3, 6, palette.Colors["COLOR_BOMB"])
+	rl.DrawRectangleRoundedLinesEx(tile, 0.3, 6, 2, rl.White)
+
+	letter := powerUpLetters[kind]
+	textWidth := rl.MeasureText(letter, 24)
+	rl.DrawText(letter, int32(center.X)-textWidth/2, int32(center.Y)-12, 24, rl.White)
+}
@@ -0,0 +1,25 @@
+package ui
+
+import (
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// teleporterColors gives each pad pair its own colour
+var teleporterColors = map[int]rl.Color{
+	1: rl.SkyBlue,
+	2: rl.Lime,
+	3: rl.Gold,
+	4: rl.Pink,
+}
+
+// DrawTeleporter draws a teleporter pad, dimmed while it is cooling down
+func DrawTeleporter(pad entities.TeleporterState) {
+	color := teleporterColors[pad.ID]
+	if pad.Cooldown > 0 {
+		color = rl.Fade(color, 0.3)
+	}
+	center := rl.NewVector2(float32(pad.Position.X*50+25), float32(pad.Position.Y*50+25))
+	rl.DrawRing(center, 12, 20, 0, 360, 32, color)
+	rl.DrawCircleV(center, 6, color)
+}
@@ -0,0 +1,32 @@
+package ui
+
+import (
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// DrawTerrain draws the markings on top of special terrain cells: arrows on
+// conveyors and planks on bridges
+func DrawTerrain(position world.IVector2, cell world.Cell) {
+	center := rl.NewVector2(float32(position.X*50+25), float32(position.Y*50+25))
+	color := rl.Fade(rl.Black, 0.4)
+
+	if dir, found := cell.ConveyorDirection(); found {
+		// Arrow tip pointing in the conveyor direction
+		forward := rl.NewVector2(float32(dir.X), float32(dir.Y))
+		side := rl.NewVector2(-forward.Y, forward.X)
+		tip := rl.Vector2Add(center, rl.Vector2Scale(forward, 15))
+		back := rl.Vector2Subtract(center, rl.Vector2Scale(forward, 10))
+		left := rl.Vector2Add(back, rl.Vector2Scale(side, 12))
+		right := rl.Vector2Subtract(back, rl.Vector2Scale(side, 12))
+		rl.DrawTriangle(tip, right, left, color)
+		rl.DrawTriangle(tip, left, right, color)
+		return
+	}
+
+	if cell == world.CellBridge {
+		for i := int32(0); i < 3; i++ {
+			rl.DrawRectangle(int32(position.X*50), int32(position.Y*50)+4+i*16, 50, 10, color)
+		}
+	}
+}
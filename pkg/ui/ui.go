@@ -1,8 +1,12 @@
 package ui
 
 import (
+	"fmt"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
 	"github.com/engpetarmarinov/eepers-go/pkg/game"
 	"github.com/engpetarmarinov/eepers-go/pkg/palette"
+	"github.com/engpetarmarinov/eepers-go/pkg/world"
 	rl "github.com/gen2brain/raylib-go/raylib"
 )
 
@@ -10,10 +14,18 @@ const cellSize float32 = 50.0
 
 // DrawUI draws the game's UI with visual inventory display.
 func DrawUI(gs *game.State, screenWidth int32) {
-	// Draw keys as circles
-	for i := 0; i < gs.Player.Keys; i++ {
-		position := rl.NewVector2(100.0+float32(i)*cellSize, 100.0)
-		rl.DrawCircleV(position, cellSize*0.25, palette.Colors["COLOR_DOORKEY"])
+	// Draw keys as circles, grouped by colour with a gap between the groups
+	keyX := float32(100.0)
+	for color, count := range gs.Player.Keys {
+		if count == 0 {
+			continue
+		}
+		for i := 0; i < count; i++ {
+			position := rl.NewVector2(keyX, 100.0)
+			rl.DrawCircleV(position, cellSize*0.25, world.KeyColor(color).Color())
+			keyX += cellSize
+		}
+		keyX += cellSize * 0.5
 	}
 
 	// Draw bombs as circles - only show available bombs
@@ -23,6 +35,43 @@ func DrawUI(gs *game.State, screenWidth int32) {
 		rl.DrawCircleV(position, cellSize*0.5, palette.Colors["COLOR_BOMB"])
 	}
 
+	// Draw special bombs below, one icon per kind with its count
+	specialX := float32(100.0)
+	for kind := entities.BombRemote; kind < entities.BombKindCount; kind++ {
+		count := gs.Player.SpecialBombs[kind]
+		if count == 0 {
+			continue
+		}
+		position := rl.NewVector2(specialX, 290.0)
+		DrawBombIcon(kind, position, cellSize*0.35)
+		countText := fmt.Sprintf("x%d", count)
+		rl.DrawText(countText, int32(position.X+cellSize*0.45), int32(position.Y)-10, 20, palette.Colors["COLOR_LABEL"])
+		specialX += cellSize * 2
+	}
+
+	// Mark the bomb kind that will be planted next
+	selectedText := fmt.Sprintf("Bomb: %s (Tab)", gs.Player.SelectedBomb)
+	rl.DrawText(selectedText, 75, 330, 20, palette.Colors["COLOR_LABEL"])
+
+	// List the active power-ups
+	modifiers := gs.Player.Modifiers
+	var powerUps []string
+	if modifiers.BlastRange > 0 {
+		powerUps = append(powerUps, fmt.Sprintf("Range +%d", modifiers.BlastRange))
+	}
+	if modifiers.FuseReduction > 0 {
+		powerUps = append(powerUps, fmt.Sprintf("Fuse -%d", modifiers.FuseReduction))
+	}
+	if modifiers.Kick {
+		powerUps = append(powerUps, "Kick")
+	}
+	if modifiers.ImmunityTurns > 0 {
+		powerUps = append(powerUps, fmt.Sprintf("Immune %d", modifiers.ImmunityTurns))
+	}
+	for i, powerUp := range powerUps {
+		rl.DrawText(powerUp, 75, 360+int32(i)*25, 20, palette.Colors["COLOR_LABEL"])
+	}
+
 	// Draw health bar
 	healthBarWidth := int32(200)
 	healthBarHeight := int32(20)
@@ -0,0 +1,30 @@
+package ui
+
+import (
+	"math"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/entities"
+	"github.com/engpetarmarinov/eepers-go/pkg/game"
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// DrawEeperVisionCone draws the view cone of an eeper in world space
+func DrawEeperVisionCone(gs *game.State, eeper entities.EeperState) {
+	centerX, centerY := game.EeperCenter(&eeper)
+	facingX, facingY := game.EeperFacing(&eeper)
+
+	center := rl.NewVector2(float32(centerX*50), float32(centerY*50))
+	radius := float32(gs.EeperVisionRange(&eeper) * 50)
+	facingAngle := float32(math.Atan2(facingY, facingX) * 180 / math.Pi)
+	halfAngle := gs.Rules.GuardVisionAngle
+
+	// Cones turn from calm to alarmed as the alert meter fills up
+	color := rl.Fade(rl.Yellow, 0.15)
+	if eeper.Behaviour == entities.BehaviourChase {
+		color = rl.Fade(rl.Red, 0.2)
+	} else if eeper.Alert > 0 {
+		color = rl.Fade(rl.Orange, 0.15+0.1*eeper.Alert)
+	}
+
+	rl.DrawCircleSector(center, radius, facingAngle-halfAngle, facingAngle+halfAngle, 24, color)
+}
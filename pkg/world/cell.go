@@ -15,8 +15,88 @@ const (
 	CellDoor
 	CellBarricade
 	CellExplosion
+	CellDoorRed
+	CellDoorGreen
+	CellDoorYellow
+	CellPlate      // Pressure plate, holds its gates toggled while something stands on it
+	CellLever      // Lever, toggles its gates each time it is stepped on or blasted
+	CellGateClosed // Gate blocking the way like a wall
+	CellGateOpen   // Gate that can be walked through like floor
+	CellBlock      // Pushable block, see game.State.Blocks
+	CellIce        // Slippery floor, the player and gnomes slide over it
+	CellConveyorUp
+	CellConveyorDown
+	CellConveyorLeft
+	CellConveyorRight
+	CellPit       // Deadly hole, turned into a bridge by explosions
+	CellBridge    // Pit filled by an explosion, walkable like floor
+	CellWeakPoint // Boss weak point, destroyed by explosions to lower the boss's shield
 )
 
+// conveyorDirections maps each conveyor cell to the direction it moves things in
+var conveyorDirections = map[Cell]IVector2{
+	CellConveyorUp:    {X: 0, Y: -1},
+	CellConveyorDown:  {X: 0, Y: 1},
+	CellConveyorLeft:  {X: -1, Y: 0},
+	CellConveyorRight: {X: 1, Y: 0},
+}
+
+// ConveyorDirection returns the direction a conveyor cell moves things in,
+// and whether the cell is a conveyor at all.
+func (c Cell) ConveyorDirection() (IVector2, bool) {
+	dir, found := conveyorDirections[c]
+	return dir, found
+}
+
+// doorCells maps each key colour to the door cell it opens
+var doorCells = [KeyColorCount]Cell{
+	KeyCyan:   CellDoor,
+	KeyRed:    CellDoorRed,
+	KeyGreen:  CellDoorGreen,
+	KeyYellow: CellDoorYellow,
+}
+
+// DoorCell returns the door cell opened by keys of the given colour.
+func DoorCell(color KeyColor) Cell {
+	return doorCells[color]
+}
+
+// IsDoor reports whether the cell is a door of any colour.
+func (c Cell) IsDoor() bool {
+	return c == CellDoor || c == CellDoorRed || c == CellDoorGreen || c == CellDoorYellow
+}
+
+// DoorColor returns the colour of the keys opening the door cell.
+func (c Cell) DoorColor() KeyColor {
+	for color, door := range doorCells {
+		if door == c {
+			return KeyColor(color)
+		}
+	}
+	return KeyCyan
+}
+
+// IsOpaque reports whether the cell blocks line of sight.
+func (c Cell) IsOpaque() bool {
+	return c == CellWall || c.IsDoor() || c == CellBarricade || c == CellGateClosed || c == CellBlock || c == CellWeakPoint
+}
+
+// IsWalkable reports whether the player and eepers can stand on the cell like on floor.
+func (c Cell) IsWalkable() bool {
+	switch c {
+	case CellFloor, CellPlate, CellLever, CellGateOpen, CellIce, CellBridge,
+		CellConveyorUp, CellConveyorDown, CellConveyorLeft, CellConveyorRight:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsWiring reports whether the cell belongs to the wiring (plates, levers and gates).
+func (c Cell) IsWiring() bool {
+	return c == CellPlate || c == CellLever || c == CellGateClosed || c == CellGateOpen
+}
+
 // CellColor returns the color for a given cell type.
 func CellColor(c Cell) rl.Color {
 	switch c {
@@ -32,6 +112,29 @@ func CellColor(c Cell) rl.Color {
 		return palette.Colors["COLOR_BARRICADE"]
 	case CellExplosion:
 		return palette.Colors["COLOR_EXPLOSION"]
+	case CellDoorRed, CellDoorGreen, CellDoorYellow:
+		return c.DoorColor().Color()
+	case CellPlate:
+		return palette.Colors["COLOR_PLATE"]
+	case CellLever:
+		return palette.Colors["COLOR_LEVER"]
+	case CellGateClosed:
+		return palette.Colors["COLOR_GATE"]
+	case CellGateOpen:
+		return palette.Colors["COLOR_GATE_OPEN"]
+	case CellBlock:
+		// The block itself is drawn on top, animated
+		return palette.Colors["COLOR_FLOOR"]
+	case CellIce:
+		return palette.Colors["COLOR_ICE"]
+	case CellConveyorUp, CellConveyorDown, CellConveyorLeft, CellConveyorRight:
+		return palette.Colors["COLOR_CONVEYOR"]
+	case CellPit:
+		return palette.Colors["COLOR_PIT"]
+	case CellBridge:
+		return palette.Colors["COLOR_BRIDGE"]
+	case CellWeakPoint:
+		return palette.Colors["COLOR_WEAKPOINT"]
 	default:
 		return rl.Black
 	}
@@ -0,0 +1,75 @@
+package world
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/engpetarmarinov/eepers-go/pkg/palette"
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// KeyColor represents the colour of a key and of the doors it opens.
+type KeyColor int
+
+const (
+	KeyCyan KeyColor = iota // The classic key colour
+	KeyRed
+	KeyGreen
+	KeyYellow
+	KeyColorCount
+)
+
+// String returns the name of the key colour as used in level configs.
+func (k KeyColor) String() string {
+	switch k {
+	case KeyCyan:
+		return "Cyan"
+	case KeyRed:
+		return "Red"
+	case KeyGreen:
+		return "Green"
+	case KeyYellow:
+		return "Yellow"
+	default:
+		return "Unknown"
+	}
+}
+
+// MarshalText encodes the key colour by name.
+func (k KeyColor) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// UnmarshalText decodes a key colour from its (case-insensitive) name.
+func (k *KeyColor) UnmarshalText(text []byte) error {
+	color, err := ParseKeyColor(string(text))
+	if err != nil {
+		return err
+	}
+	*k = color
+	return nil
+}
+
+// ParseKeyColor returns the key colour with the given (case-insensitive) name.
+func ParseKeyColor(name string) (KeyColor, error) {
+	for k := KeyColor(0); k < KeyColorCount; k++ {
+		if strings.EqualFold(k.String(), name) {
+			return k, nil
+		}
+	}
+	return KeyCyan, fmt.Errorf("unknown key colour %q", name)
+}
+
+// Color returns the colour used to draw keys and doors of this key colour.
+func (k KeyColor) Color() rl.Color {
+	switch k {
+	case KeyRed:
+		return palette.Colors["COLOR_DOORKEY_RED"]
+	case KeyGreen:
+		return palette.Colors["COLOR_DOORKEY_GREEN"]
+	case KeyYellow:
+		return palette.Colors["COLOR_DOORKEY_YELLOW"]
+	default:
+		return palette.Colors["COLOR_DOORKEY"]
+	}
+}
@@ -0,0 +1,40 @@
+package world
+
+// Line returns all cells on the Bresenham line from a to b, both included.
+func Line(a, b IVector2) []IVector2 {
+	dx := abs(b.X - a.X)
+	dy := -abs(b.Y - a.Y)
+	sx, sy := 1, 1
+	if a.X > b.X {
+		sx = -1
+	}
+	if a.Y > b.Y {
+		sy = -1
+	}
+
+	cells := []IVector2{}
+	err := dx + dy
+	pos := a
+	for {
+		cells = append(cells, pos)
+		if pos == b {
+			return cells
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			pos.X += sx
+		}
+		if e2 <= dx {
+			err += dx
+			pos.Y += sy
+		}
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}